@@ -22,7 +22,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
 	// load credential helpers
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -60,7 +62,13 @@ func init() {
 }
 
 func main() {
-	ctx := context.Background()
+	// commands like "workload tail" run until they are interrupted, cancel the context to let them
+	// clean up before exiting. A second interrupt exits right away
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
 
 	p, err := plugin.NewPlugin(&tanzucliv1alpha1.PluginDescriptor{
 		Name:           "apps",
@@ -82,6 +90,8 @@ func main() {
 	c := cli.Initialize(fmt.Sprintf("tanzu %s", p.Cmd.Use), scheme)
 	p.AddCommands(
 		commands.NewClusterSupplyChainCommand(ctx, c),
+		commands.NewClusterDeliveryCommand(ctx, c),
+		commands.NewDeliverableCommand(ctx, c),
 		commands.NewWorkloadCommand(ctx, c),
 
 		// hidden commands
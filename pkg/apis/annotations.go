@@ -17,3 +17,6 @@ limitations under the License.
 package apis
 
 const ServiceClaimAnnotationName = "serviceclaims.supplychain.apps.x-tanzu.vmware.com/extensions"
+
+// WorkloadHistoryAnnotationName holds the previous specs of a workload submitted with the CLI
+const WorkloadHistoryAnnotationName = "apps.tanzu.vmware.com/workload-history"
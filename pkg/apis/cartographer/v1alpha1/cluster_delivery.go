@@ -0,0 +1,90 @@
+// Copyright 2023 VMware
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +kubebuilder:object:generate=true
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ClusterDeliveryKind = "ClusterDelivery"
+)
+
+const (
+	DeliveryReady          = "Ready"
+	DeliveryTemplatesReady = "TemplatesReady"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+type ClusterDelivery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              DeliverySpec   `json:"spec"`
+	Status            DeliveryStatus `json:"status,omitempty"`
+}
+
+type DeliverySpec struct {
+	Resources                []DeliveryResource                `json:"resources"`
+	Params                   []DelegatableParam                `json:"params,omitempty"`
+	ServiceAccountRef        ServiceAccountRef                 `json:"serviceAccountRef,omitempty"`
+	Selector                 map[string]string                 `json:"selector,omitempty"`
+	SelectorMatchExpressions []metav1.LabelSelectorRequirement `json:"selectorMatchExpressions,omitempty"`
+	SelectorMatchFields      []FieldSelectorRequirement        `json:"selectorMatchFields,omitempty"`
+}
+
+type DeliveryResource struct {
+	Name        string                    `json:"name"`
+	TemplateRef DeliveryTemplateReference `json:"templateRef"`
+	Params      []DelegatableParam        `json:"params,omitempty"`
+	Sources     []ResourceReference       `json:"sources,omitempty"`
+	Deployment  *DeploymentReference      `json:"deployment,omitempty"`
+	Configs     []ResourceReference       `json:"configs,omitempty"`
+}
+
+type DeliveryTemplateReference struct {
+	//+kubebuilder:validation:Enum=ClusterSourceTemplate;ClusterDeploymentTemplate;ClusterTemplate;ClusterConfigTemplate
+	Kind string `json:"kind"`
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+type DeploymentReference struct {
+	Resource string `json:"resource"`
+}
+
+type DeliveryStatus struct {
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type ClusterDeliveryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterDelivery `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&ClusterDelivery{},
+		&ClusterDeliveryList{},
+	)
+}
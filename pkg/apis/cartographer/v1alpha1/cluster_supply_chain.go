@@ -54,6 +54,13 @@ type SupplyChainSpec struct {
 
 type FieldSelectorOperator string
 
+const (
+	FieldSelectorOpIn           FieldSelectorOperator = "In"
+	FieldSelectorOpNotIn        FieldSelectorOperator = "NotIn"
+	FieldSelectorOpExists       FieldSelectorOperator = "Exists"
+	FieldSelectorOpDoesNotExist FieldSelectorOperator = "DoesNotExist"
+)
+
 type FieldSelectorRequirement struct {
 	// +kubebuilder:validation:MinLength=1
 	Key string `json:"key"`
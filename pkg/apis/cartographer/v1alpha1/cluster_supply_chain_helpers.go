@@ -17,9 +17,261 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
 )
 
 func (sc *ClusterSupplyChain) GetGroupVersionKind() schema.GroupVersionKind {
 	return SchemeGroupVersion.WithKind("ClusterSupplyChain")
 }
+
+// GetGroupVersionKind returns the kind of the referenced template, the templates are part of the
+// same group as the supply chain.
+func (r *SupplyChainTemplateReference) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(r.Kind)
+}
+
+// Template returns the referenced template as an unstructured object to fetch from the cluster.
+// The template kinds are not part of the scheme, only their common fields are read.
+func (r *SupplyChainTemplateReference) Template() *unstructured.Unstructured {
+	template := &unstructured.Unstructured{}
+	template.SetGroupVersionKind(r.GetGroupVersionKind())
+	template.SetName(r.Name)
+	return template
+}
+
+// MatchWorkload returns the number of selector terms of the supply chain the workload matches, or
+// zero when any of the terms does not match. Like Cartographer, a supply chain without any
+// selector does not match any workload.
+func (sc *ClusterSupplyChain) MatchWorkload(workload *Workload) (int, error) {
+	spec := sc.Spec
+	terms := len(spec.Selector) + len(spec.SelectorMatchExpressions) + len(spec.SelectorMatchFields)
+	if terms == 0 {
+		return 0, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchLabels:      spec.Selector,
+		MatchExpressions: spec.SelectorMatchExpressions,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !selector.Matches(labels.Set(workload.Labels)) {
+		return 0, nil
+	}
+
+	if len(spec.SelectorMatchFields) != 0 {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(workload)
+		if err != nil {
+			return 0, err
+		}
+		for _, requirement := range spec.SelectorMatchFields {
+			matches, err := requirement.Matches(obj)
+			if err != nil {
+				return 0, err
+			}
+			if !matches {
+				return 0, nil
+			}
+		}
+	}
+
+	return terms, nil
+}
+
+// Matches evaluates the requirement against the field of the object found at the Key path, e.g.
+// "spec.source.image".
+func (r *FieldSelectorRequirement) Matches(obj map[string]interface{}) (bool, error) {
+	path := jsonpath.New(r.Key)
+	path.AllowMissingKeys(true)
+	if err := path.Parse(fmt.Sprintf("{.%s}", strings.TrimPrefix(r.Key, "."))); err != nil {
+		return false, fmt.Errorf("invalid field selector key %q: %w", r.Key, err)
+	}
+	results, err := path.FindResults(obj)
+	if err != nil {
+		return false, err
+	}
+	values := []string{}
+	for _, result := range results {
+		for _, value := range result {
+			if value.IsValid() && value.Interface() != nil {
+				values = append(values, fmt.Sprintf("%v", value.Interface()))
+			}
+		}
+	}
+
+	switch r.Operator {
+	case FieldSelectorOpExists:
+		return len(values) != 0, nil
+	case FieldSelectorOpDoesNotExist:
+		return len(values) == 0, nil
+	case FieldSelectorOpIn:
+		return len(values) != 0 && containsAll(r.Values, values), nil
+	case FieldSelectorOpNotIn:
+		return len(values) == 0 || !containsAny(r.Values, values), nil
+	default:
+		return false, fmt.Errorf("unsupported field selector operator %q", r.Operator)
+	}
+}
+
+// MatchingClusterSupplyChains returns the supply chains that match the workload with the most
+// selector terms, the one Cartographer would choose for the workload. More than one supply chain
+// means the choice is ambiguous and the workload would not be taken by any of them.
+func MatchingClusterSupplyChains(supplyChains []ClusterSupplyChain, workload *Workload) ([]ClusterSupplyChain, error) {
+	best := 0
+	matches := []ClusterSupplyChain{}
+	for i := range supplyChains {
+		terms, err := supplyChains[i].MatchWorkload(workload)
+		if err != nil {
+			return nil, fmt.Errorf("cluster supply chain %q: %w", supplyChains[i].Name, err)
+		}
+		if terms == 0 || terms < best {
+			continue
+		}
+		if terms > best {
+			best = terms
+			matches = matches[:0]
+		}
+		matches = append(matches, supplyChains[i])
+	}
+	return matches, nil
+}
+
+const (
+	ParamSourceWorkload           = "workload"
+	ParamSourceResourceValue      = "resource value"
+	ParamSourceResourceDefault    = "resource default"
+	ParamSourceSupplyChainValue   = "supply chain value"
+	ParamSourceSupplyChainDefault = "supply chain default"
+	ParamSourceTemplateDefault    = "template default"
+)
+
+// EffectiveParam is the value the template of a supply chain resource gets for one of the params it
+// declares, along with where the value comes from.
+type EffectiveParam struct {
+	Resource string
+	Name     string
+	Value    *apiextensionsv1.JSON
+	Source   string
+}
+
+// GetTemplateParams returns the params the template declares, with their default value.
+func GetTemplateParams(template *unstructured.Unstructured) (TemplateParams, error) {
+	items, _, err := unstructured.NestedSlice(template.Object, "spec", "params")
+	if err != nil {
+		return nil, err
+	}
+	params := make(TemplateParams, 0, len(items))
+	for _, item := range items {
+		u, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid param %v of template %s %q", item, template.GetKind(), template.GetName())
+		}
+		param := TemplateParam{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, &param); err != nil {
+			return nil, err
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+// ResolveParams returns the value each template of the supply chain gets for the params it
+// declares, following the precedence Cartographer applies. A param set on the resource wins over
+// one set on the supply chain, a "value" can not be overridden by the workload while a "default"
+// only applies when the workload does not set the param, and the template default applies last.
+// The templates params are looked up by resource name.
+//
+// It also returns the workload params that no template declares, and the ones that are ignored
+// because a "value" set on the supply chain or on the resource wins over them.
+func (sc *ClusterSupplyChain) ResolveParams(workload *Workload, templatesParams map[string]TemplateParams) (params []EffectiveParam, unused []string, overridden []string) {
+	workloadParams := map[string]*apiextensionsv1.JSON{}
+	for i := range workload.Spec.Params {
+		workloadParams[workload.Spec.Params[i].Name] = &workload.Spec.Params[i].Value
+	}
+	lookup := func(params []DelegatableParam, name string) *DelegatableParam {
+		for i := range params {
+			if params[i].Name == name {
+				return &params[i]
+			}
+		}
+		return nil
+	}
+
+	declared := map[string]bool{}
+	ignored := map[string]bool{}
+	params = []EffectiveParam{}
+	for _, resource := range sc.Spec.Resources {
+		for _, templateParam := range templatesParams[resource.Name] {
+			declared[templateParam.Name] = true
+			param := EffectiveParam{Resource: resource.Name, Name: templateParam.Name}
+			workloadValue, fromWorkload := workloadParams[templateParam.Name]
+
+			delegated, valueSource, defaultSource := lookup(resource.Params, templateParam.Name), ParamSourceResourceValue, ParamSourceResourceDefault
+			if delegated == nil {
+				delegated, valueSource, defaultSource = lookup(sc.Spec.Params, templateParam.Name), ParamSourceSupplyChainValue, ParamSourceSupplyChainDefault
+			}
+			switch {
+			case delegated != nil && delegated.Value != nil:
+				param.Value, param.Source = delegated.Value, valueSource
+				if fromWorkload {
+					ignored[templateParam.Name] = true
+				}
+			case fromWorkload:
+				param.Value, param.Source = workloadValue, ParamSourceWorkload
+			case delegated != nil:
+				param.Value, param.Source = delegated.DefaultValue, defaultSource
+			default:
+				param.Source = ParamSourceTemplateDefault
+				if len(templateParam.DefaultValue.Raw) != 0 {
+					param.Value = templateParam.DefaultValue.DeepCopy()
+				}
+			}
+			params = append(params, param)
+		}
+	}
+
+	unused = []string{}
+	for name := range workloadParams {
+		if !declared[name] {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+	overridden = []string{}
+	for name := range ignored {
+		overridden = append(overridden, name)
+	}
+	sort.Strings(overridden)
+	return params, unused, overridden
+}
+
+func containsAll(list []string, values []string) bool {
+	for _, value := range values {
+		if !containsAny(list, []string{value}) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAny(list []string, values []string) bool {
+	for _, item := range list {
+		for _, value := range values {
+			if item == value {
+				return true
+			}
+		}
+	}
+	return false
+}
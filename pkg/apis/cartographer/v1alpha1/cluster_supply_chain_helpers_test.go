@@ -0,0 +1,372 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestClusterSupplyChainMatchWorkload(t *testing.T) {
+	workload := &Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-workload",
+			Labels: map[string]string{
+				"apps.tanzu.vmware.com/workload-type": "web",
+				"app.kubernetes.io/part-of":           "petclinic",
+			},
+		},
+		Spec: WorkloadSpec{
+			Source: &Source{
+				Git: &GitSource{URL: "https://example.com/repo.git"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		spec        SupplyChainSpec
+		want        int
+		shouldError bool
+	}{{
+		name: "no selectors",
+		want: 0,
+	}, {
+		name: "labels",
+		spec: SupplyChainSpec{
+			Selector: map[string]string{"apps.tanzu.vmware.com/workload-type": "web"},
+		},
+		want: 1,
+	}, {
+		name: "labels mismatch",
+		spec: SupplyChainSpec{
+			Selector: map[string]string{"apps.tanzu.vmware.com/workload-type": "worker"},
+		},
+		want: 0,
+	}, {
+		name: "expressions",
+		spec: SupplyChainSpec{
+			SelectorMatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      "app.kubernetes.io/part-of",
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{"petclinic", "bookstore"},
+			}},
+		},
+		want: 1,
+	}, {
+		name: "all terms",
+		spec: SupplyChainSpec{
+			Selector: map[string]string{"apps.tanzu.vmware.com/workload-type": "web"},
+			SelectorMatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      "app.kubernetes.io/part-of",
+				Operator: metav1.LabelSelectorOpExists,
+			}},
+			SelectorMatchFields: []FieldSelectorRequirement{{
+				Key:      "spec.source.git",
+				Operator: FieldSelectorOpExists,
+			}, {
+				Key:      "spec.image",
+				Operator: FieldSelectorOpDoesNotExist,
+			}},
+		},
+		want: 4,
+	}, {
+		name: "field in",
+		spec: SupplyChainSpec{
+			SelectorMatchFields: []FieldSelectorRequirement{{
+				Key:      "spec.source.git.url",
+				Operator: FieldSelectorOpIn,
+				Values:   []string{"https://example.com/repo.git"},
+			}},
+		},
+		want: 1,
+	}, {
+		name: "field not in",
+		spec: SupplyChainSpec{
+			SelectorMatchFields: []FieldSelectorRequirement{{
+				Key:      "spec.source.git.url",
+				Operator: FieldSelectorOpNotIn,
+				Values:   []string{"https://example.com/repo.git"},
+			}},
+		},
+		want: 0,
+	}, {
+		name: "missing field not in",
+		spec: SupplyChainSpec{
+			SelectorMatchFields: []FieldSelectorRequirement{{
+				Key:      "spec.image",
+				Operator: FieldSelectorOpNotIn,
+				Values:   []string{"nginx"},
+			}},
+		},
+		want: 1,
+	}, {
+		name: "field exists mismatch",
+		spec: SupplyChainSpec{
+			Selector: map[string]string{"apps.tanzu.vmware.com/workload-type": "web"},
+			SelectorMatchFields: []FieldSelectorRequirement{{
+				Key:      "spec.image",
+				Operator: FieldSelectorOpExists,
+			}},
+		},
+		want: 0,
+	}, {
+		name: "unknown operator",
+		spec: SupplyChainSpec{
+			SelectorMatchFields: []FieldSelectorRequirement{{
+				Key:      "spec.image",
+				Operator: FieldSelectorOperator("Equals"),
+			}},
+		},
+		shouldError: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sc := &ClusterSupplyChain{Spec: test.spec}
+			got, err := sc.MatchWorkload(workload)
+			if (err != nil) != test.shouldError {
+				t.Fatalf("MatchWorkload() shouldError %v, got %v", test.shouldError, err)
+			}
+			if got != test.want {
+				t.Errorf("MatchWorkload() want %d, got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestMatchingClusterSupplyChains(t *testing.T) {
+	workload := &Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"apps.tanzu.vmware.com/workload-type": "web",
+				"app.kubernetes.io/part-of":           "petclinic",
+			},
+		},
+	}
+	web := ClusterSupplyChain{
+		ObjectMeta: metav1.ObjectMeta{Name: "web"},
+		Spec: SupplyChainSpec{
+			Selector: map[string]string{"apps.tanzu.vmware.com/workload-type": "web"},
+		},
+	}
+	petclinic := ClusterSupplyChain{
+		ObjectMeta: metav1.ObjectMeta{Name: "petclinic"},
+		Spec: SupplyChainSpec{
+			Selector: map[string]string{"app.kubernetes.io/part-of": "petclinic"},
+		},
+	}
+	webPetclinic := ClusterSupplyChain{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-petclinic"},
+		Spec: SupplyChainSpec{
+			Selector: map[string]string{
+				"apps.tanzu.vmware.com/workload-type": "web",
+				"app.kubernetes.io/part-of":           "petclinic",
+			},
+		},
+	}
+	worker := ClusterSupplyChain{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+		Spec: SupplyChainSpec{
+			Selector: map[string]string{"apps.tanzu.vmware.com/workload-type": "worker"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		supplyChains []ClusterSupplyChain
+		want         []string
+	}{{
+		name:         "no supply chains",
+		supplyChains: []ClusterSupplyChain{},
+		want:         []string{},
+	}, {
+		name:         "no match",
+		supplyChains: []ClusterSupplyChain{worker},
+		want:         []string{},
+	}, {
+		name:         "single match",
+		supplyChains: []ClusterSupplyChain{web, worker},
+		want:         []string{"web"},
+	}, {
+		name:         "most specific match",
+		supplyChains: []ClusterSupplyChain{web, webPetclinic, petclinic},
+		want:         []string{"web-petclinic"},
+	}, {
+		name:         "ambiguous match",
+		supplyChains: []ClusterSupplyChain{web, worker, petclinic},
+		want:         []string{"web", "petclinic"},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			matches, err := MatchingClusterSupplyChains(test.supplyChains, workload)
+			if err != nil {
+				t.Fatalf("MatchingClusterSupplyChains() errored %v", err)
+			}
+			got := []string{}
+			for _, sc := range matches {
+				got = append(got, sc.Name)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("MatchingClusterSupplyChains() (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestGetTemplateParams(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    map[string]interface{}
+		want        TemplateParams
+		shouldError bool
+	}{{
+		name:     "no params",
+		template: map[string]interface{}{"spec": map[string]interface{}{}},
+		want:     TemplateParams{},
+	}, {
+		name: "params",
+		template: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"params": []interface{}{
+					map[string]interface{}{"name": "port", "default": int64(8080)},
+					map[string]interface{}{"name": "annotations"},
+				},
+			},
+		},
+		want: TemplateParams{
+			{Name: "port", DefaultValue: apiextensionsv1.JSON{Raw: []byte(`8080`)}},
+			{Name: "annotations"},
+		},
+	}, {
+		name: "invalid params",
+		template: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"params": []interface{}{"port"},
+			},
+		},
+		shouldError: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := GetTemplateParams(&unstructured.Unstructured{Object: test.template})
+			if err != nil {
+				if !test.shouldError {
+					t.Errorf("GetTemplateParams() errored %v", err)
+				}
+				return
+			}
+			if test.shouldError {
+				t.Fatalf("GetTemplateParams() expected error")
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("GetTemplateParams() (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestClusterSupplyChainResolveParams(t *testing.T) {
+	value := func(raw string) *apiextensionsv1.JSON {
+		return &apiextensionsv1.JSON{Raw: []byte(raw)}
+	}
+	templatesParams := map[string]TemplateParams{
+		"source-provider": {
+			{Name: "gitops_ssh_secret", DefaultValue: apiextensionsv1.JSON{Raw: []byte(`"git-ssh"`)}},
+		},
+		"config-provider": {
+			{Name: "ports"},
+			{Name: "annotations"},
+		},
+	}
+	supplyChain := &ClusterSupplyChain{
+		Spec: SupplyChainSpec{
+			Resources: []SupplyChainResource{{
+				Name: "source-provider",
+			}, {
+				Name: "config-provider",
+				Params: []DelegatableParam{
+					{Name: "ports", DefaultValue: value(`[8080]`)},
+				},
+			}},
+			Params: []DelegatableParam{
+				{Name: "annotations", Value: value(`{"team":"apps"}`)},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		params         []Param
+		wantParams     []EffectiveParam
+		wantUnused     []string
+		wantOverridden []string
+	}{{
+		name: "defaults",
+		wantParams: []EffectiveParam{
+			{Resource: "source-provider", Name: "gitops_ssh_secret", Value: value(`"git-ssh"`), Source: ParamSourceTemplateDefault},
+			{Resource: "config-provider", Name: "ports", Value: value(`[8080]`), Source: ParamSourceResourceDefault},
+			{Resource: "config-provider", Name: "annotations", Value: value(`{"team":"apps"}`), Source: ParamSourceSupplyChainValue},
+		},
+		wantUnused:     []string{},
+		wantOverridden: []string{},
+	}, {
+		name: "workload params",
+		params: []Param{
+			{Name: "gitops_ssh_secret", Value: apiextensionsv1.JSON{Raw: []byte(`"my-secret"`)}},
+			{Name: "ports", Value: apiextensionsv1.JSON{Raw: []byte(`[9090]`)}},
+		},
+		wantParams: []EffectiveParam{
+			{Resource: "source-provider", Name: "gitops_ssh_secret", Value: value(`"my-secret"`), Source: ParamSourceWorkload},
+			{Resource: "config-provider", Name: "ports", Value: value(`[9090]`), Source: ParamSourceWorkload},
+			{Resource: "config-provider", Name: "annotations", Value: value(`{"team":"apps"}`), Source: ParamSourceSupplyChainValue},
+		},
+		wantUnused:     []string{},
+		wantOverridden: []string{},
+	}, {
+		name: "unused and overridden params",
+		params: []Param{
+			{Name: "port", Value: apiextensionsv1.JSON{Raw: []byte(`9090`)}},
+			{Name: "annotations", Value: apiextensionsv1.JSON{Raw: []byte(`{}`)}},
+		},
+		wantParams: []EffectiveParam{
+			{Resource: "source-provider", Name: "gitops_ssh_secret", Value: value(`"git-ssh"`), Source: ParamSourceTemplateDefault},
+			{Resource: "config-provider", Name: "ports", Value: value(`[8080]`), Source: ParamSourceResourceDefault},
+			{Resource: "config-provider", Name: "annotations", Value: value(`{"team":"apps"}`), Source: ParamSourceSupplyChainValue},
+		},
+		wantUnused:     []string{"port"},
+		wantOverridden: []string{"annotations"},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			workload := &Workload{Spec: WorkloadSpec{Params: test.params}}
+			params, unused, overridden := supplyChain.ResolveParams(workload, templatesParams)
+			if diff := cmp.Diff(test.wantParams, params); diff != "" {
+				t.Errorf("ResolveParams() params (-want, +got) = %v", diff)
+			}
+			if diff := cmp.Diff(test.wantUnused, unused); diff != "" {
+				t.Errorf("ResolveParams() unused (-want, +got) = %v", diff)
+			}
+			if diff := cmp.Diff(test.wantOverridden, overridden); diff != "" {
+				t.Errorf("ResolveParams() overridden (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
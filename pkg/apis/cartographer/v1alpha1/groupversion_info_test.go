@@ -38,6 +38,14 @@ func TestGetGroupVersionKind(t *testing.T) {
 			Version: "v1alpha1",
 			Kind:    "ClusterSupplyChain",
 		},
+	}, {
+		name:     "SupplyChainTemplateReference",
+		resource: &SupplyChainTemplateReference{Kind: "ClusterImageTemplate", Name: "kpack-template"},
+		want: schema.GroupVersionKind{
+			Group:   "carto.run",
+			Version: "v1alpha1",
+			Kind:    "ClusterImageTemplate",
+		},
 	}, {
 		name:     "Workload",
 		resource: &Workload{},
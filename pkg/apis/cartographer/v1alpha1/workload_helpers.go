@@ -22,12 +22,16 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
@@ -55,10 +59,41 @@ func (w *Workload) GetGroupVersionKind() schema.GroupVersionKind {
 }
 
 func (w *Workload) Load(in io.Reader) error {
-	if err := w.loadAndValidateDocuments(in); err != nil {
+	workloads, err := loadDocuments(in)
+	if err != nil {
 		return err
 	}
+	if len(workloads) > 1 {
+		return fmt.Errorf("files containing multiple workload descriptions are not supported")
+	}
+	if len(workloads) == 1 {
+		workloads[0].DeepCopyInto(w)
+	}
+	return w.validateAndClearTypeMeta()
+}
+
+// LoadWorkloads reads every workload description contained in the input. Empty documents are
+// skipped and each remaining document must describe a Workload resource.
+func LoadWorkloads(in io.Reader) ([]*Workload, error) {
+	workloads, err := loadDocuments(in)
+	if err != nil {
+		return nil, err
+	}
+	if len(workloads) == 0 {
+		return nil, (&Workload{}).validateAndClearTypeMeta()
+	}
+	for i, w := range workloads {
+		if err := w.validateAndClearTypeMeta(); err != nil {
+			if len(workloads) == 1 {
+				return nil, err
+			}
+			return nil, fmt.Errorf("document %d: %w", i+1, err)
+		}
+	}
+	return workloads, nil
+}
 
+func (w *Workload) validateAndClearTypeMeta() error {
 	if apiVersion, kind := SchemeGroupVersion.Identifier(), "Workload"; w.APIVersion != apiVersion || w.Kind != kind {
 		return fmt.Errorf("file must contain resource with API Version %q and Kind %q", apiVersion, kind)
 	}
@@ -67,27 +102,23 @@ func (w *Workload) Load(in io.Reader) error {
 	return nil
 }
 
-func (w *Workload) loadAndValidateDocuments(in io.Reader) error {
+func loadDocuments(in io.Reader) ([]*Workload, error) {
 	d := yaml.NewYAMLOrJSONDecoder(in, 4096)
-	documents := 0
+	workloads := []*Workload{}
 	for {
 		var workload *Workload
 		if err := d.Decode(&workload); err != nil {
 			if err == io.EOF {
 				break
 			}
-			return err
+			return nil, err
 		}
 		if workload == nil {
 			continue
 		}
-		if documents > 0 {
-			return fmt.Errorf("files containing multiple workload descriptions are not supported")
-		}
-		workload.DeepCopyInto(w)
-		documents++
+		workloads = append(workloads, workload)
 	}
-	return nil
+	return workloads, nil
 }
 
 func (w *WorkloadSpec) MergeServiceAccountName(serviceAccountName string) {
@@ -580,3 +611,144 @@ func (w *Workload) GetNotices(ctx context.Context) []string {
 
 	return msgs
 }
+
+// WorkloadHistory holds the specs submitted for a workload, oldest first, and the number of
+// revisions to keep. It is stored as JSON in the workload history annotation.
+type WorkloadHistory struct {
+	Limit     int                `json:"limit"`
+	Revisions []WorkloadRevision `json:"revisions"`
+}
+
+// WorkloadRevision is a spec submitted for a workload along with who submitted it and when.
+type WorkloadRevision struct {
+	Revision  int          `json:"revision"`
+	Author    string       `json:"author,omitempty"`
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+	Spec      WorkloadSpec `json:"spec"`
+}
+
+// GetHistory returns the history recorded in the workload annotations, or nil if there is none.
+func (w *Workload) GetHistory() (*WorkloadHistory, error) {
+	value := w.GetAnnotations()[apis.WorkloadHistoryAnnotationName]
+	if value == "" {
+		return nil, nil
+	}
+	history := &WorkloadHistory{}
+	if err := json.Unmarshal([]byte(value), history); err != nil {
+		return nil, fmt.Errorf("unable to read %q annotation: %w", apis.WorkloadHistoryAnnotationName, err)
+	}
+	return history, nil
+}
+
+// SetHistory records the history in the workload annotations, a nil history removes it.
+func (w *Workload) SetHistory(history *WorkloadHistory) error {
+	if history == nil {
+		delete(w.Annotations, apis.WorkloadHistoryAnnotationName)
+		return nil
+	}
+	value, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+	w.MergeAnnotations(apis.WorkloadHistoryAnnotationName, string(value))
+	return nil
+}
+
+// AddRevision records the spec as the newest revision, unless it matches the newest revision
+// already recorded, and drops the oldest revisions beyond the history limit.
+func (h *WorkloadHistory) AddRevision(author string, timestamp *metav1.Time, spec WorkloadSpec) {
+	revision := 1
+	if len(h.Revisions) != 0 {
+		revision = h.Revisions[len(h.Revisions)-1].Revision + 1
+	}
+	if latest := h.LatestRevision(); latest == nil || !equality.Semantic.DeepEqual(latest.Spec, spec) {
+		h.Revisions = append(h.Revisions, WorkloadRevision{
+			Revision:  revision,
+			Author:    author,
+			Timestamp: timestamp,
+			Spec:      *spec.DeepCopy(),
+		})
+	}
+	if h.Limit > 0 && len(h.Revisions) > h.Limit {
+		h.Revisions = h.Revisions[len(h.Revisions)-h.Limit:]
+	}
+}
+
+// GetRevision returns the revision with the given number, or nil if it is not in the history.
+func (h *WorkloadHistory) GetRevision(revision int) *WorkloadRevision {
+	for i := range h.Revisions {
+		if h.Revisions[i].Revision == revision {
+			return &h.Revisions[i]
+		}
+	}
+	return nil
+}
+
+// LatestRevision returns the newest revision in the history, or nil if the history is empty.
+func (h *WorkloadHistory) LatestRevision() *WorkloadRevision {
+	if len(h.Revisions) == 0 {
+		return nil
+	}
+	return &h.Revisions[len(h.Revisions)-1]
+}
+
+const (
+	OutputImage    = "image"
+	OutputURL      = "url"
+	OutputRevision = "revision"
+	OutputConfig   = "config"
+)
+
+// templateOutputPaths are the fields of the templates holding the path, in the stamped object, of
+// the value of each output.
+var templateOutputPaths = map[string]string{
+	OutputImage:    "imagePath",
+	OutputURL:      "urlPath",
+	OutputRevision: "revisionPath",
+	OutputConfig:   "configPath",
+}
+
+// GetResourceWithOutput returns the last resource of the workload, in the order of the supply
+// chain, that produces the output, or nil when none of them does.
+func (w *Workload) GetResourceWithOutput(name string) *RealizedResource {
+	for i := len(w.Status.Resources) - 1; i >= 0; i-- {
+		for _, output := range w.Status.Resources[i].Outputs {
+			if output.Name == name {
+				return &w.Status.Resources[i]
+			}
+		}
+	}
+	return nil
+}
+
+// GetOutputPath returns the path the template reads the output from in the objects it stamps, e.g.
+// ".status.latestImage" for the image of a ClusterImageTemplate.
+func GetOutputPath(template *unstructured.Unstructured, output string) (string, error) {
+	field, ok := templateOutputPaths[output]
+	if !ok {
+		return "", fmt.Errorf("unknown output %q", output)
+	}
+	path, _, _ := unstructured.NestedString(template.Object, "spec", field)
+	if path == "" {
+		return "", fmt.Errorf("template %s %q does not define the %s output", template.GetKind(), template.GetName(), output)
+	}
+	return path, nil
+}
+
+// GetOutputValue returns the full value found at the output path of the stamped object. The path
+// may omit the braces and leading dot of a JSONPath expression, like in the templates.
+func GetOutputValue(stamped *unstructured.Unstructured, path string) (interface{}, error) {
+	expression := path
+	if !strings.HasPrefix(expression, "{") {
+		expression = fmt.Sprintf("{.%s}", strings.TrimPrefix(expression, "."))
+	}
+	parser := jsonpath.New(path)
+	if err := parser.Parse(expression); err != nil {
+		return nil, fmt.Errorf("invalid output path %q: %w", path, err)
+	}
+	results, err := parser.FindResults(stamped.Object)
+	if err != nil || len(results) == 0 || len(results[0]) == 0 {
+		return nil, fmt.Errorf("output path %q not found in %s %q", path, stamped.GetKind(), stamped.GetName())
+	}
+	return results[0][0].Interface(), nil
+}
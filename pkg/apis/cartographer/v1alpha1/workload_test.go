@@ -28,6 +28,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
@@ -131,6 +132,101 @@ func TestWorkload_Load(t *testing.T) {
 	}
 }
 
+func TestLoadWorkloads(t *testing.T) {
+	petclinic := func(name string) *Workload {
+		return &Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					"app.kubernetes.io/part-of":           name,
+					"apps.tanzu.vmware.com/workload-type": "web",
+				},
+			},
+			Spec: WorkloadSpec{
+				Source: &Source{
+					Git: &GitSource{
+						URL: "https://github.com/spring-projects/spring-petclinic.git",
+						Ref: GitRef{
+							Branch: "main",
+						},
+					},
+				},
+				Env: []corev1.EnvVar{
+					{
+						Name:  "SPRING_PROFILES_ACTIVE",
+						Value: "mysql",
+					},
+				},
+				Resources: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("500m"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("100m"),
+						corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name      string
+		file      string
+		want      []*Workload
+		shouldErr bool
+	}{{
+		name: "loads single workload",
+		file: "testdata/workload.yaml",
+		want: []*Workload{petclinic("spring-petclinic")},
+	}, {
+		name: "loads every workload",
+		file: "testdata/multidocument.yaml",
+		want: []*Workload{
+			petclinic("spring-petclinic0"),
+			petclinic("spring-petclinic1"),
+			petclinic("spring-petclinic2"),
+		},
+	}, {
+		name: "skips empty documents",
+		file: "testdata/multidocument_first_last_empty.yaml",
+		want: []*Workload{petclinic("spring-petclinic")},
+	}, {
+		name:      "not a workload",
+		file:      "testdata/supplychain.yaml",
+		shouldErr: true,
+	}, {
+		name:      "mixed resources",
+		file:      "testdata/multidocument_mixed.yaml",
+		shouldErr: true,
+	}, {
+		name:      "malformed",
+		file:      "testdata/malformed.yaml",
+		shouldErr: true,
+	}, {
+		name:      "missing",
+		file:      "testdata/missing.yaml",
+		shouldErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, _ := os.Open(test.file)
+			defer f.Close()
+
+			got, err := LoadWorkloads(f)
+
+			if (err == nil) == test.shouldErr {
+				t.Errorf("LoadWorkloads() shouldErr %t %v", test.shouldErr, err)
+			} else if test.shouldErr {
+				return
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("LoadWorkloads() (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
 func TestWorkload_MergeServiceAccountName(t *testing.T) {
 	serviceAccount := "test-service-account"
 	updatedServiceAccount := "updated-service-account"
@@ -2515,3 +2611,306 @@ func TestGetNotices(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadHistory(t *testing.T) {
+	timestamp := metav1.NewTime(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+	history := &WorkloadHistory{
+		Limit: 3,
+		Revisions: []WorkloadRevision{{
+			Revision: 1,
+			Spec:     WorkloadSpec{Image: "ubuntu:bionic"},
+		}, {
+			Revision:  2,
+			Author:    "alice",
+			Timestamp: &timestamp,
+			Spec:      WorkloadSpec{Image: "ubuntu:focal"},
+		}},
+	}
+
+	tests := []struct {
+		name    string
+		seed    *Workload
+		history *WorkloadHistory
+		want    *Workload
+	}{{
+		name:    "set history",
+		seed:    &Workload{},
+		history: history,
+		want: &Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					apis.WorkloadHistoryAnnotationName: `{"limit":3,"revisions":[{"revision":1,"spec":{"image":"ubuntu:bionic"}},{"revision":2,"author":"alice","timestamp":"2023-01-02T03:04:05Z","spec":{"image":"ubuntu:focal"}}]}`,
+				},
+			},
+		},
+	}, {
+		name: "remove history",
+		seed: &Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					apis.WorkloadHistoryAnnotationName: `{"limit":3,"revisions":[]}`,
+					"foo":                              "bar",
+				},
+			},
+		},
+		want: &Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					"foo": "bar",
+				},
+			},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.seed.DeepCopy()
+			if err := got.SetHistory(test.history); err != nil {
+				t.Fatalf("SetHistory() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("SetHistory() (-want, +got) = %v", diff)
+			}
+			gotHistory, err := got.GetHistory()
+			if err != nil {
+				t.Fatalf("GetHistory() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.history, gotHistory); diff != "" {
+				t.Errorf("GetHistory() (-want, +got) = %v", diff)
+			}
+		})
+	}
+
+	t.Run("invalid history", func(t *testing.T) {
+		workload := &Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					apis.WorkloadHistoryAnnotationName: "not json",
+				},
+			},
+		}
+		if _, err := workload.GetHistory(); err == nil {
+			t.Errorf("GetHistory() expected error")
+		}
+	})
+}
+
+func TestWorkloadHistory_AddRevision(t *testing.T) {
+	tests := []struct {
+		name string
+		seed *WorkloadHistory
+		spec WorkloadSpec
+		want *WorkloadHistory
+	}{{
+		name: "first revision",
+		seed: &WorkloadHistory{Limit: 2},
+		spec: WorkloadSpec{Image: "ubuntu:bionic"},
+		want: &WorkloadHistory{
+			Limit: 2,
+			Revisions: []WorkloadRevision{
+				{Revision: 1, Author: "alice", Spec: WorkloadSpec{Image: "ubuntu:bionic"}},
+			},
+		},
+	}, {
+		name: "drops revisions beyond the limit",
+		seed: &WorkloadHistory{
+			Limit: 2,
+			Revisions: []WorkloadRevision{
+				{Revision: 4, Spec: WorkloadSpec{Image: "ubuntu:bionic"}},
+				{Revision: 5, Spec: WorkloadSpec{Image: "ubuntu:focal"}},
+			},
+		},
+		spec: WorkloadSpec{Image: "ubuntu:jammy"},
+		want: &WorkloadHistory{
+			Limit: 2,
+			Revisions: []WorkloadRevision{
+				{Revision: 5, Spec: WorkloadSpec{Image: "ubuntu:focal"}},
+				{Revision: 6, Author: "alice", Spec: WorkloadSpec{Image: "ubuntu:jammy"}},
+			},
+		},
+	}, {
+		name: "unchanged spec",
+		seed: &WorkloadHistory{
+			Limit: 1,
+			Revisions: []WorkloadRevision{
+				{Revision: 1, Spec: WorkloadSpec{Image: "ubuntu:bionic"}},
+				{Revision: 2, Spec: WorkloadSpec{Image: "ubuntu:focal"}},
+			},
+		},
+		spec: WorkloadSpec{Image: "ubuntu:focal"},
+		want: &WorkloadHistory{
+			Limit: 1,
+			Revisions: []WorkloadRevision{
+				{Revision: 2, Spec: WorkloadSpec{Image: "ubuntu:focal"}},
+			},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.seed
+			got.AddRevision("alice", nil, test.spec)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("AddRevision() (-want, +got) = %v", diff)
+			}
+			if latest := got.LatestRevision(); latest == nil || latest.Revision != test.want.Revisions[len(test.want.Revisions)-1].Revision {
+				t.Errorf("LatestRevision() = %v", latest)
+			}
+			if revision := got.GetRevision(0); revision != nil {
+				t.Errorf("GetRevision(0) = %v, want nil", revision)
+			}
+		})
+	}
+}
+
+func TestWorkload_GetResourceWithOutput(t *testing.T) {
+	workload := &Workload{
+		Status: WorkloadStatus{
+			Resources: []RealizedResource{
+				{Name: "source-provider", Outputs: []Output{{Name: OutputURL}, {Name: OutputRevision}}},
+				{Name: "image-builder", Outputs: []Output{{Name: OutputImage}}},
+				{Name: "source-tester", Outputs: []Output{{Name: OutputURL}, {Name: OutputRevision}}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		output string
+		want   string
+	}{{
+		name:   "single resource",
+		output: OutputImage,
+		want:   "image-builder",
+	}, {
+		name:   "last resource",
+		output: OutputURL,
+		want:   "source-tester",
+	}, {
+		name:   "no resource",
+		output: OutputConfig,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := ""
+			if resource := workload.GetResourceWithOutput(test.output); resource != nil {
+				got = resource.Name
+			}
+			if got != test.want {
+				t.Errorf("GetResourceWithOutput() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGetOutputPath(t *testing.T) {
+	template := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "carto.run/v1alpha1",
+			"kind":       "ClusterSourceTemplate",
+			"metadata": map[string]interface{}{
+				"name": "source-template",
+			},
+			"spec": map[string]interface{}{
+				"urlPath":      ".status.artifact.url",
+				"revisionPath": ".status.artifact.revision",
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		output      string
+		want        string
+		shouldError bool
+	}{{
+		name:   "url",
+		output: OutputURL,
+		want:   ".status.artifact.url",
+	}, {
+		name:   "revision",
+		output: OutputRevision,
+		want:   ".status.artifact.revision",
+	}, {
+		name:        "not defined",
+		output:      OutputImage,
+		shouldError: true,
+	}, {
+		name:        "unknown output",
+		output:      "source",
+		shouldError: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := GetOutputPath(template, test.output)
+			if (err != nil) != test.shouldError {
+				t.Errorf("GetOutputPath() error = %v, shouldError %v", err, test.shouldError)
+			}
+			if got != test.want {
+				t.Errorf("GetOutputPath() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGetOutputValue(t *testing.T) {
+	stamped := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "source.toolkit.fluxcd.io/v1beta1",
+			"kind":       "GitRepository",
+			"metadata": map[string]interface{}{
+				"name": "my-workload",
+			},
+			"status": map[string]interface{}{
+				"artifact": map[string]interface{}{
+					"url":      "http://source-controller/gitrepository/default/my-workload/3d42c19a.tar.gz",
+					"revision": "main/3d42c19a618bb8fc13f72178b8b5e214a2f989c4",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		path        string
+		want        interface{}
+		shouldError bool
+	}{{
+		name: "path",
+		path: ".status.artifact.url",
+		want: "http://source-controller/gitrepository/default/my-workload/3d42c19a.tar.gz",
+	}, {
+		name: "path without leading dot",
+		path: "status.artifact.revision",
+		want: "main/3d42c19a618bb8fc13f72178b8b5e214a2f989c4",
+	}, {
+		name: "jsonpath expression",
+		path: "{.status.artifact}",
+		want: map[string]interface{}{
+			"url":      "http://source-controller/gitrepository/default/my-workload/3d42c19a.tar.gz",
+			"revision": "main/3d42c19a618bb8fc13f72178b8b5e214a2f989c4",
+		},
+	}, {
+		name:        "missing field",
+		path:        ".status.latestImage",
+		shouldError: true,
+	}, {
+		name:        "invalid path",
+		path:        "{.status",
+		shouldError: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := GetOutputValue(stamped, test.path)
+			if (err != nil) != test.shouldError {
+				t.Errorf("GetOutputValue() error = %v, shouldError %v", err, test.shouldError)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("GetOutputValue() (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
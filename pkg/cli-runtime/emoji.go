@@ -34,4 +34,5 @@ const (
 	Question        Icon = '❓'
 	ThumbsUp        Icon = '👍'
 	Exclamation     Icon = '❗'
+	Bell            Icon = '🔔'
 )
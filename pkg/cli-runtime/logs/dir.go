@@ -0,0 +1,191 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LogDirIndexFile is the name of the index of the files written in a LogDir
+const LogDirIndexFile = "index.json"
+
+// LogDir saves the lines of each container to its own file, "<dir>/<pod>/<container>.log". Once a
+// file reaches the maximum size, it is rotated to "<container>.<n>.log" and a new file is started,
+// the rotated files are kept. The index of the files, with the time of their first and last lines,
+// is written when a file is rotated and when the LogDir is closed.
+type LogDir struct {
+	Path    string
+	MaxSize int64
+
+	now    func() time.Time
+	m      sync.Mutex
+	closed bool
+	files  map[string]*logFile
+	index  LogDirIndex
+}
+
+// LogDirIndex describes the files written in a LogDir.
+type LogDirIndex struct {
+	StartTime *time.Time        `json:"startTime,omitempty"`
+	EndTime   *time.Time        `json:"endTime,omitempty"`
+	Files     []LogDirFileEntry `json:"files"`
+}
+
+// LogDirFileEntry describes a file of a container, the path is relative to the LogDir.
+type LogDirFileEntry struct {
+	Pod       string    `json:"pod"`
+	Container string    `json:"container"`
+	Path      string    `json:"path"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	Lines     int64     `json:"lines"`
+}
+
+type logFile struct {
+	file      *os.File
+	size      int64
+	rotations int
+	entry     LogDirFileEntry
+}
+
+// NewLogDir creates the directory, files larger than maxSize are rotated, they are never rotated
+// when maxSize is not positive.
+func NewLogDir(path string, maxSize int64) (*LogDir, error) {
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, err
+	}
+	return &LogDir{
+		Path:    path,
+		MaxSize: maxSize,
+		now:     time.Now,
+		files:   map[string]*logFile{},
+		index:   LogDirIndex{Files: []LogDirFileEntry{}},
+	}, nil
+}
+
+// Write appends the line to the file of the container. The lines written once the LogDir is closed
+// are dropped.
+func (d *LogDir) Write(pod, container, line string) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	if d.closed {
+		return nil
+	}
+
+	now := d.now()
+	if d.index.StartTime == nil {
+		d.index.StartTime = &now
+	}
+	d.index.EndTime = &now
+
+	key := filepath.Join(pod, container)
+	f, ok := d.files[key]
+	if !ok {
+		if err := os.MkdirAll(filepath.Join(d.Path, pod), 0755); err != nil {
+			return err
+		}
+		f = &logFile{}
+		d.files[key] = f
+	}
+	data := line + "\n"
+	if f.file != nil && d.MaxSize > 0 && f.size > 0 && f.size+int64(len(data)) > d.MaxSize {
+		if err := d.rotate(f); err != nil {
+			return err
+		}
+	}
+	if f.file == nil {
+		path := filepath.Join(pod, fmt.Sprintf("%s.log", container))
+		file, err := os.OpenFile(filepath.Join(d.Path, path), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		f.file = file
+		f.size = 0
+		f.entry = LogDirFileEntry{Pod: pod, Container: container, Path: path, StartTime: now}
+	}
+	n, err := f.file.WriteString(data)
+	f.size += int64(n)
+	f.entry.EndTime = now
+	f.entry.Lines++
+	return err
+}
+
+// rotate closes the current file of the container and renames it with the next rotation number.
+func (d *LogDir) rotate(f *logFile) error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	f.file = nil
+	f.rotations++
+	rotated := filepath.Join(f.entry.Pod, fmt.Sprintf("%s.%d.log", f.entry.Container, f.rotations))
+	if err := os.Rename(filepath.Join(d.Path, f.entry.Path), filepath.Join(d.Path, rotated)); err != nil {
+		return err
+	}
+	entry := f.entry
+	entry.Path = rotated
+	d.index.Files = append(d.index.Files, entry)
+	return d.writeIndex(d.index.Files)
+}
+
+// Close closes the files and writes the index.
+func (d *LogDir) Close() error {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	if d.closed {
+		return nil
+	}
+	d.closed = true
+
+	var err error
+	files := append([]LogDirFileEntry{}, d.index.Files...)
+	for _, f := range d.files {
+		if f.file == nil {
+			continue
+		}
+		if closeErr := f.file.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		f.file = nil
+		files = append(files, f.entry)
+	}
+	if indexErr := d.writeIndex(files); indexErr != nil && err == nil {
+		err = indexErr
+	}
+	return err
+}
+
+// writeIndex writes the index with the files, sorted by path.
+func (d *LogDir) writeIndex(files []LogDirFileEntry) error {
+	index := d.index
+	index.Files = append([]LogDirFileEntry{}, files...)
+	sort.SliceStable(index.Files, func(i, j int) bool {
+		return index.Files[i].Path < index.Files[j].Path
+	})
+	b, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(d.Path, LogDirIndexFile), append(b, '\n'), 0644)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLogDir(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		maxSize       int64
+		lines         [][]string
+		expectedFiles map[string]string
+		expectedIndex LogDirIndex
+	}{{
+		name:    "files per container",
+		maxSize: 1024,
+		lines: [][]string{
+			{"my-pod", "workload", "line 1"},
+			{"my-pod", "queue-proxy", "line 2"},
+			{"my-pod", "workload", "line 3"},
+		},
+		expectedFiles: map[string]string{
+			"my-pod/workload.log":    "line 1\nline 3\n",
+			"my-pod/queue-proxy.log": "line 2\n",
+		},
+		expectedIndex: LogDirIndex{
+			StartTime: timePtr(start.Add(1 * time.Second)),
+			EndTime:   timePtr(start.Add(3 * time.Second)),
+			Files: []LogDirFileEntry{
+				{Pod: "my-pod", Container: "queue-proxy", Path: "my-pod/queue-proxy.log", StartTime: start.Add(2 * time.Second), EndTime: start.Add(2 * time.Second), Lines: 1},
+				{Pod: "my-pod", Container: "workload", Path: "my-pod/workload.log", StartTime: start.Add(1 * time.Second), EndTime: start.Add(3 * time.Second), Lines: 2},
+			},
+		},
+	}, {
+		name:    "rotate files",
+		maxSize: 14,
+		lines: [][]string{
+			{"my-pod", "workload", "line 1"},
+			{"my-pod", "workload", "line 2"},
+			{"my-pod", "workload", "line 3"},
+		},
+		expectedFiles: map[string]string{
+			"my-pod/workload.1.log": "line 1\nline 2\n",
+			"my-pod/workload.log":   "line 3\n",
+		},
+		expectedIndex: LogDirIndex{
+			StartTime: timePtr(start.Add(1 * time.Second)),
+			EndTime:   timePtr(start.Add(3 * time.Second)),
+			Files: []LogDirFileEntry{
+				{Pod: "my-pod", Container: "workload", Path: "my-pod/workload.1.log", StartTime: start.Add(1 * time.Second), EndTime: start.Add(2 * time.Second), Lines: 2},
+				{Pod: "my-pod", Container: "workload", Path: "my-pod/workload.log", StartTime: start.Add(3 * time.Second), EndTime: start.Add(3 * time.Second), Lines: 1},
+			},
+		},
+	}, {
+		name:    "never rotate",
+		maxSize: 0,
+		lines: [][]string{
+			{"my-pod", "workload", "line 1"},
+			{"my-pod", "workload", "line 2"},
+		},
+		expectedFiles: map[string]string{
+			"my-pod/workload.log": "line 1\nline 2\n",
+		},
+		expectedIndex: LogDirIndex{
+			StartTime: timePtr(start.Add(1 * time.Second)),
+			EndTime:   timePtr(start.Add(2 * time.Second)),
+			Files: []LogDirFileEntry{
+				{Pod: "my-pod", Container: "workload", Path: "my-pod/workload.log", StartTime: start.Add(1 * time.Second), EndTime: start.Add(2 * time.Second), Lines: 2},
+			},
+		},
+	}, {
+		name:          "no lines",
+		maxSize:       1024,
+		lines:         [][]string{},
+		expectedFiles: map[string]string{},
+		expectedIndex: LogDirIndex{
+			Files: []LogDirFileEntry{},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "logs")
+			dir, err := NewLogDir(path, test.maxSize)
+			if err != nil {
+				t.Fatalf("NewLogDir() errored %v", err)
+			}
+			clock := start
+			dir.now = func() time.Time {
+				clock = clock.Add(time.Second)
+				return clock
+			}
+
+			for _, line := range test.lines {
+				if err := dir.Write(line[0], line[1], line[2]); err != nil {
+					t.Fatalf("Write() errored %v", err)
+				}
+			}
+			if err := dir.Close(); err != nil {
+				t.Fatalf("Close() errored %v", err)
+			}
+			// lines written once closed are dropped
+			if err := dir.Write("my-pod", "workload", "dropped"); err != nil {
+				t.Fatalf("Write() errored %v", err)
+			}
+
+			for file, expected := range test.expectedFiles {
+				b, err := os.ReadFile(filepath.Join(path, file))
+				if err != nil {
+					t.Fatalf("ReadFile() errored %v", err)
+				}
+				if diff := cmp.Diff(expected, string(b)); diff != "" {
+					t.Errorf("%s (-expected, +actual) = %v", file, diff)
+				}
+			}
+
+			b, err := os.ReadFile(filepath.Join(path, LogDirIndexFile))
+			if err != nil {
+				t.Fatalf("ReadFile() errored %v", err)
+			}
+			index := LogDirIndex{}
+			if err := json.Unmarshal(b, &index); err != nil {
+				t.Fatalf("Unmarshal() errored %v", err)
+			}
+			if diff := cmp.Diff(test.expectedIndex, index); diff != "" {
+				t.Errorf("%s (-expected, +actual) = %v", LogDirIndexFile, diff)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -18,7 +18,6 @@ package logs
 
 import (
 	"context"
-	"time"
 
 	"github.com/fatih/color"
 	"github.com/stretchr/testify/mock"
@@ -33,13 +32,21 @@ type FakeTailer struct {
 	mock.Mock
 }
 
-func (f *FakeTailer) Tail(ctx context.Context, c *cli.Config, namespace string, selector labels.Selector, containers []string, since time.Duration, timestamps bool) error {
-	args := f.Called(ctx, namespace, selector, containers, since, timestamps)
+func (f *FakeTailer) Tail(ctx context.Context, c *cli.Config, namespace string, selector labels.Selector, opts TailOptions) error {
+	args := f.Called(ctx, namespace, selector, opts)
 	c.Printf(color.CyanString("...tail output...\n"))
 	if err := args.Error(0); err != nil {
 		return err
 	}
-	// simulate tailing until the context is closed
-	<-ctx.Done()
+	if opts.Follow {
+		// simulate tailing until the context is closed
+		<-ctx.Done()
+	}
 	return nil
 }
+
+func (f *FakeTailer) Logs(ctx context.Context, c *cli.Config, namespace string, pod string, containers []string, tailLines int64) error {
+	args := f.Called(ctx, namespace, pod, containers, tailLines)
+	c.Printf(color.CyanString("...log output...\n"))
+	return args.Error(0)
+}
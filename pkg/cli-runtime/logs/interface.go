@@ -26,16 +26,76 @@ import (
 	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
 )
 
+const (
+	// OutputJSON prints each line as a JSON object with the pod, container, namespace, timestamp
+	// and message
+	OutputJSON = "json"
+	// OutputRaw prints the message of each line as it was written
+	OutputRaw = "raw"
+)
+
+// PodGroup is a named set of pods, e.g. the pods created for a step of a supply chain.
+type PodGroup struct {
+	// Name prefixes the lines of the pods when set
+	Name string
+	Pods []string
+}
+
+// TailOptions select the logs to tail and how each line is printed.
+type TailOptions struct {
+	// Containers to tail, all the containers of the pods when empty
+	Containers []string
+	// Since is how far back the logs are read from, all the logs when zero
+	Since time.Duration
+	// Follow keeps printing the logs as they are written, until the context is done. Otherwise the
+	// logs written so far are printed and Tail returns
+	Follow bool
+	// Previous prints the logs of the previous instance of the containers that restarted, along
+	// with their restart count. The logs of a terminated instance cannot be followed
+	Previous bool
+	// TailLines is the number of lines to print from the end of the logs of each container, all
+	// the lines are printed when nil
+	TailLines *int64
+	// Timestamps prefixes each line with the time it was written
+	Timestamps bool
+	// Include are regular expressions, only the lines matching one of them are printed when set
+	Include []string
+	// Exclude are regular expressions, the lines matching one of them are not printed
+	Exclude []string
+	// PodGroups restrict the logs to the pods of the groups, the pods matching the selector are all
+	// tailed when empty. When the logs are not followed, they are printed group after group
+	PodGroups []PodGroup
+	// OutputDir is the directory where the lines of each container are saved, in addition to
+	// being printed, see LogDir
+	OutputDir string
+	// OutputMaxSize is the size in bytes the files of OutputDir are rotated at
+	OutputMaxSize int64
+	// Output is the format of the lines, OutputJSON or OutputRaw, each line is prefixed with the
+	// pod and container names when empty
+	Output string
+}
+
 type Tailer interface {
-	Tail(ctx context.Context, c *cli.Config, namespace string, selector labels.Selector, containers []string, since time.Duration, timestamps bool) error
+	Tail(ctx context.Context, c *cli.Config, namespace string, selector labels.Selector, opts TailOptions) error
+	// Logs prints the last lines the containers of the pod wrote, without following them. All the
+	// containers of the pod, including the init containers, are printed when none are given.
+	Logs(ctx context.Context, c *cli.Config, namespace string, pod string, containers []string, tailLines int64) error
+}
+
+func Tail(ctx context.Context, c *cli.Config, namespace string, selector labels.Selector, opts TailOptions) error {
+	tailer := RetrieveTailer(ctx)
+	if tailer == nil {
+		return fmt.Errorf("unable to retrieve tailer from the context: set the tailer on context with StashTailer(ctx context.Context, tailer Tailer) context.Context")
+	}
+	return tailer.Tail(ctx, c, namespace, selector, opts)
 }
 
-func Tail(ctx context.Context, c *cli.Config, namespace string, selector labels.Selector, containers []string, since time.Duration, timestamps bool) error {
+func Logs(ctx context.Context, c *cli.Config, namespace string, pod string, containers []string, tailLines int64) error {
 	tailer := RetrieveTailer(ctx)
 	if tailer == nil {
 		return fmt.Errorf("unable to retrieve tailer from the context: set the tailer on context with StashTailer(ctx context.Context, tailer Tailer) context.Context")
 	}
-	return tailer.Tail(ctx, c, namespace, selector, containers, since, timestamps)
+	return tailer.Logs(ctx, c, namespace, pod, containers, tailLines)
 }
 
 var tailerStashKey = struct{}{}
@@ -17,39 +17,97 @@ limitations under the License.
 package logs
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"text/template"
 	"time"
 
 	"github.com/fatih/color"
 	"github.com/stern/stern/stern"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
 
 	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
 )
 
 const ansi = "[\u001b\u009b][[()#;?]*(?:[0-9]{1,4}(?:;[0-9]{0,4})*)?[0-9A-ORZcf-nqry=><]"
 
+// followSinceAll is the duration the followed logs are read since when they are not limited, as
+// stern always sends it to the API server, which rejects a zero duration.
+const followSinceAll = 10 * 365 * 24 * time.Hour
+
 var _ Tailer = &SternTailer{}
 var re = regexp.MustCompile(ansi)
 
-type SternTailer struct{}
+type SternTailer struct {
+	// run follows the logs, stern.Run when nil
+	run func(ctx context.Context, config *stern.Config) error
+}
 
-func (s *SternTailer) Tail(ctx context.Context, c *cli.Config, namespace string, selector labels.Selector, containers []string, since time.Duration, timestamps bool) error {
+func (s *SternTailer) Tail(ctx context.Context, c *cli.Config, namespace string, selector labels.Selector, opts TailOptions) (err error) {
 	containerQuery := regexp.MustCompile(".*")
-	if len(containers) != 0 {
+	if len(opts.Containers) != 0 {
 		escapedContainers := []string{}
-		for _, c := range containers {
+		for _, c := range opts.Containers {
 			escapedContainers = append(escapedContainers, regexp.QuoteMeta(c))
 		}
 		containerQuery = regexp.MustCompile(fmt.Sprintf("^(%s)$", strings.Join(escapedContainers, "|")))
 	}
-	t := "{{color .ContainerColor .PodName}}{{color .PodColor \"[\"}}{{color .PodColor .ContainerName}}{{color .PodColor \"]\"}} {{format .Message}}\n"
+	include, err := compileExpressions(opts.Include)
+	if err != nil {
+		return err
+	}
+	exclude, err := compileExpressions(opts.Exclude)
+	if err != nil {
+		return err
+	}
+
+	podQuery := regexp.MustCompile("")
+	groups := map[string]string{}
+	if len(opts.PodGroups) != 0 {
+		escapedPods := []string{}
+		for _, group := range opts.PodGroups {
+			for _, pod := range group.Pods {
+				escapedPods = append(escapedPods, regexp.QuoteMeta(pod))
+				groups[pod] = group.Name
+			}
+		}
+		podQuery = regexp.MustCompile(fmt.Sprintf("^(%s)$", strings.Join(escapedPods, "|")))
+	}
+
+	timestamps := opts.Timestamps
+	var t string
+	switch opts.Output {
+	case OutputJSON:
+		// the timestamp is split from the message into its own field
+		timestamps = true
+		t = "{{jsonLine .}}\n"
+	case OutputRaw:
+		t = "{{with group .PodName}}{{.}} {{end}}{{format .Message}}\n"
+	default:
+		t = "{{with group .PodName}}{{bold .}} {{end}}{{color .ContainerColor .PodName}}{{color .PodColor \"[\"}}{{color .PodColor .ContainerName}}{{color .PodColor \"]\"}} {{format .Message}}\n"
+	}
+	var dir *LogDir
+	if opts.OutputDir != "" {
+		if dir, err = NewLogDir(opts.OutputDir, opts.OutputMaxSize); err != nil {
+			return err
+		}
+		defer func() {
+			if closeErr := dir.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+		// each line is saved to the file of its container while it is printed
+		t = "{{save .}}" + t
+	}
 	funs := map[string]interface{}{
 		"json": func(in interface{}) (string, error) {
 			b, err := json.Marshal(in)
@@ -58,6 +116,18 @@ func (s *SternTailer) Tail(ctx context.Context, c *cli.Config, namespace string,
 			}
 			return string(b), nil
 		},
+		"jsonLine": func(log stern.Log) (string, error) {
+			return jsonLine(log, groups[log.PodName])
+		},
+		"save": func(log stern.Log) (string, error) {
+			return "", dir.Write(log.PodName, log.ContainerName, re.ReplaceAllString(log.Message, ""))
+		},
+		"group": func(pod string) string {
+			return groups[pod]
+		},
+		"bold": func(text string) string {
+			return color.New(color.Bold).Sprint(text)
+		},
 		"format": func(in string) string {
 			return stripANSIColor(in)
 		},
@@ -70,6 +140,21 @@ func (s *SternTailer) Tail(ctx context.Context, c *cli.Config, namespace string,
 		panic(err)
 	}
 
+	if !opts.Follow || opts.Previous {
+		return s.dump(ctx, c, namespace, selector, containerQuery, template, opts, &stern.TailOptions{
+			Timestamps:   timestamps,
+			Location:     time.Local,
+			SinceSeconds: int64(opts.Since.Seconds()),
+			Exclude:      exclude,
+			Include:      include,
+			TailLines:    opts.TailLines,
+		})
+	}
+
+	since := opts.Since
+	if since < time.Second {
+		since = followSinceAll
+	}
 	configStern := stern.Config{
 		KubeConfig:     c.KubeConfigFile,
 		ContextName:    c.CurrentContext,
@@ -82,12 +167,15 @@ func (s *SternTailer) Tail(ctx context.Context, c *cli.Config, namespace string,
 			stern.RUNNING,
 			stern.TERMINATED,
 		},
+		Include:        include,
+		Exclude:        exclude,
 		InitContainers: true,
 		Since:          since,
+		TailLines:      opts.TailLines,
 
-		// PodQuery and FieldSelector are required, but we use LabelSelector instead
-		PodQuery:      regexp.MustCompile(""),
-		FieldSelector: fields.Everything(),
+		// PodQuery and FieldSelector are required, but we mostly use LabelSelector instead
+		PodQuery:      podQuery,
+		FieldSelector: podsFieldSelector(opts.PodGroups),
 
 		Template: template,
 		Out:      c.Stdout,
@@ -95,7 +183,240 @@ func (s *SternTailer) Tail(ctx context.Context, c *cli.Config, namespace string,
 		Follow:   true,
 	}
 
-	return stern.Run(ctx, &configStern)
+	run := s.run
+	if run == nil {
+		run = stern.Run
+	}
+	// the logs are followed until the context is done, e.g. when the command is interrupted, so
+	// the output directory is closed once they stop
+	return run(ctx, &configStern)
+}
+
+// dump prints the logs the containers of the pods wrote so far, reading them straight from the API
+// server as stern only stops once the context is done even when it does not follow the logs. When
+// opts.Previous is set, the logs of the previous instance of the containers that restarted are
+// printed instead, each container announced with its restart count.
+func (s *SternTailer) dump(ctx context.Context, c *cli.Config, namespace string, selector labels.Selector, containerQuery *regexp.Regexp, template *template.Template, opts TailOptions, options *stern.TailOptions) error {
+	previous := opts.Previous
+	clientset, err := kubernetes.NewForConfig(c.KubeRestConfig())
+	if err != nil {
+		return err
+	}
+	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+		FieldSelector: podsFieldSelector(opts.PodGroups).String(),
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].Name < pods.Items[j].Name
+	})
+	if len(opts.PodGroups) != 0 {
+		pods.Items = groupPods(pods.Items, opts.PodGroups)
+	}
+
+	podColor := color.New(color.FgCyan)
+	restarted := 0
+	for _, pod := range pods.Items {
+		containers := startedContainers(&pod)
+		if previous {
+			containers = restartedContainers(&pod)
+		}
+		for _, container := range containers {
+			if !containerQuery.MatchString(container) {
+				continue
+			}
+			if previous {
+				restarted++
+				printRestarts(c, &pod, container)
+			}
+			logOptions := &corev1.PodLogOptions{
+				Container:  container,
+				Timestamps: options.Timestamps,
+				TailLines:  options.TailLines,
+				Previous:   previous,
+			}
+			if options.SinceSeconds > 0 {
+				logOptions.SinceSeconds = &options.SinceSeconds
+			}
+			stream, err := clientset.CoreV1().Pods(namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+			if err != nil {
+				return err
+			}
+			scanner := bufio.NewScanner(stream)
+			for scanner.Scan() {
+				msg := scanner.Text()
+				if options.IsExclude(msg) || !options.IsInclude(msg) {
+					continue
+				}
+				if updated, err := options.UpdateTimezoneIfNeeded(msg); err != nil {
+					msg = fmt.Sprintf("[%v] %s", err, msg)
+				} else {
+					msg = updated
+				}
+				if err := template.Execute(c.Stdout, stern.Log{
+					Message:        msg,
+					NodeName:       pod.Spec.NodeName,
+					Namespace:      pod.Namespace,
+					PodName:        pod.Name,
+					ContainerName:  container,
+					PodColor:       podColor,
+					ContainerColor: podColor,
+				}); err != nil {
+					stream.Close()
+					return err
+				}
+			}
+			stream.Close()
+			if err := scanner.Err(); err != nil {
+				return err
+			}
+		}
+	}
+	if previous && restarted == 0 {
+		fmt.Fprintf(c.Stderr, "No restarted containers found, there are no previous container logs to print\n")
+	}
+	return nil
+}
+
+// startedContainers returns the names of the init containers and containers of the pod that wrote
+// logs, the ones that are not waiting to start for the first time.
+func startedContainers(pod *corev1.Pod) []string {
+	started := map[string]bool{}
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		started[status.Name] = status.State.Waiting == nil || status.RestartCount > 0
+	}
+	containers := []string{}
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if started[container.Name] {
+			containers = append(containers, container.Name)
+		}
+	}
+	return containers
+}
+
+// podsFieldSelector selects the pod by its name when the groups have a single pod, so only that pod
+// is listed, and every pod otherwise.
+func podsFieldSelector(groups []PodGroup) fields.Selector {
+	pods := []string{}
+	for _, group := range groups {
+		pods = append(pods, group.Pods...)
+	}
+	if len(pods) != 1 {
+		return fields.Everything()
+	}
+	return fields.OneTermEqualSelector("metadata.name", pods[0])
+}
+
+// groupPods returns the pods of the groups, in the order of the groups.
+func groupPods(pods []corev1.Pod, groups []PodGroup) []corev1.Pod {
+	byName := map[string]corev1.Pod{}
+	for _, pod := range pods {
+		byName[pod.Name] = pod
+	}
+	grouped := []corev1.Pod{}
+	for _, group := range groups {
+		names := append([]string{}, group.Pods...)
+		sort.Strings(names)
+		for _, name := range names {
+			if pod, ok := byName[name]; ok {
+				grouped = append(grouped, pod)
+			}
+		}
+	}
+	return grouped
+}
+
+// restartedContainers returns the names of the init containers and containers of the pod that
+// restarted, the ones with a previous instance to read the logs from.
+func restartedContainers(pod *corev1.Pod) []string {
+	restarted := map[string]bool{}
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		restarted[status.Name] = status.RestartCount > 0
+	}
+	containers := []string{}
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if restarted[container.Name] {
+			containers = append(containers, container.Name)
+		}
+	}
+	return containers
+}
+
+// printRestarts announces the logs of the previous instance of the container with the number of
+// times it restarted and why its last instance terminated, like stern announces the containers it
+// tails.
+func printRestarts(c *cli.Config, pod *corev1.Pod, container string) {
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if status.Name != container {
+			continue
+		}
+		restarts := fmt.Sprintf("restarted %d times", status.RestartCount)
+		if status.RestartCount == 1 {
+			restarts = "restarted once"
+		}
+		if terminated := status.LastTerminationState.Terminated; terminated != nil {
+			restarts = fmt.Sprintf("%s, last terminated with %s (exit code %d)", restarts, terminated.Reason, terminated.ExitCode)
+		}
+		fmt.Fprintf(c.Stderr, "%s %s › %s %s\n", color.New(color.FgHiYellow, color.Bold).Sprint("↻"), color.CyanString(pod.Name), color.CyanString(container), color.New(color.FgHiRed, color.Bold).Sprint(restarts))
+		return
+	}
+}
+
+// LogLine is a line of logs as printed with the OutputJSON format.
+type LogLine struct {
+	Group     string `json:"group,omitempty"`
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+	Namespace string `json:"namespace"`
+	Timestamp string `json:"timestamp,omitempty"`
+	Message   string `json:"message"`
+}
+
+// jsonLine formats the log with the OutputJSON format, the message of the log is expected to start
+// with its timestamp.
+func jsonLine(log stern.Log, group string) (string, error) {
+	line := LogLine{
+		Group:     group,
+		Pod:       log.PodName,
+		Container: log.ContainerName,
+		Namespace: log.Namespace,
+		Message:   log.Message,
+	}
+	if timestamp, message, ok := strings.Cut(log.Message, " "); ok {
+		if _, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+			line.Timestamp = timestamp
+			line.Message = message
+		}
+	}
+	line.Message = re.ReplaceAllString(line.Message, "")
+	b, err := json.Marshal(line)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func compileExpressions(expressions []string) ([]*regexp.Regexp, error) {
+	compiled := []*regexp.Regexp{}
+	for _, expression := range expressions {
+		r, err := regexp.Compile(expression)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, r)
+	}
+	return compiled, nil
+}
+
+// Logs prints the logs of the pod the same way as Tail does when it does not follow them.
+func (s *SternTailer) Logs(ctx context.Context, c *cli.Config, namespace string, pod string, containers []string, tailLines int64) error {
+	return s.Tail(ctx, c, namespace, labels.Everything(), TailOptions{
+		Containers: containers,
+		TailLines:  &tailLines,
+		PodGroups:  []PodGroup{{Pods: []string{pod}}},
+	})
 }
 
 func stripANSIColor(message string) string {
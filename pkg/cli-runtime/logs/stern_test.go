@@ -0,0 +1,513 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stern/stern/stern"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+)
+
+func TestSternTailerOutputDirInterrupted(t *testing.T) {
+	c := cli.NewDefaultConfig("test", runtime.NewScheme())
+	stdout := &bytes.Buffer{}
+	c.Stdout = stdout
+	c.Stderr = &bytes.Buffer{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tailer := &SternTailer{
+		// prints a line, then follows the logs until the command is interrupted
+		run: func(ctx context.Context, config *stern.Config) error {
+			podColor := color.New(color.FgCyan)
+			if err := config.Template.Execute(config.Out, stern.Log{
+				Message:        "Started PetClinicApplication",
+				Namespace:      "default",
+				PodName:        "my-pod",
+				ContainerName:  "workload",
+				PodColor:       podColor,
+				ContainerColor: podColor,
+			}); err != nil {
+				return err
+			}
+			cancel()
+			<-ctx.Done()
+			return nil
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "logs")
+	if err := tailer.Tail(ctx, c, "default", labels.Everything(), TailOptions{
+		Since:         time.Minute,
+		Follow:        true,
+		Output:        OutputRaw,
+		OutputDir:     path,
+		OutputMaxSize: 1024,
+	}); err != nil {
+		t.Fatalf("Tail() errored %v", err)
+	}
+
+	if diff := cmp.Diff("Started PetClinicApplication\n", stdout.String()); diff != "" {
+		t.Errorf("Tail() output (-expected, +actual) = %v", diff)
+	}
+	b, err := os.ReadFile(filepath.Join(path, LogDirIndexFile))
+	if err != nil {
+		t.Fatalf("ReadFile() errored %v", err)
+	}
+	index := LogDirIndex{}
+	if err := json.Unmarshal(b, &index); err != nil {
+		t.Fatalf("Unmarshal() errored %v", err)
+	}
+	if index.StartTime == nil || index.EndTime == nil {
+		t.Errorf("%s expected start and end times", LogDirIndexFile)
+	}
+	expected := []LogDirFileEntry{
+		{Pod: "my-pod", Container: "workload", Path: filepath.Join("my-pod", "workload.log"), Lines: 1},
+	}
+	if diff := cmp.Diff(expected, index.Files, cmpopts.IgnoreFields(LogDirFileEntry{}, "StartTime", "EndTime")); diff != "" {
+		t.Errorf("%s files (-expected, +actual) = %v", LogDirIndexFile, diff)
+	}
+}
+
+func TestSternTailerFollowSince(t *testing.T) {
+	tailLines := int64(20)
+	tests := []struct {
+		name     string
+		opts     TailOptions
+		expected time.Duration
+	}{{
+		name:     "since",
+		opts:     TailOptions{Since: time.Minute, Follow: true},
+		expected: time.Minute,
+	}, {
+		name:     "tail lines without since",
+		opts:     TailOptions{TailLines: &tailLines, Follow: true},
+		expected: followSinceAll,
+	}, {
+		name:     "since shorter than a second",
+		opts:     TailOptions{Since: time.Millisecond, Follow: true},
+		expected: followSinceAll,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := cli.NewDefaultConfig("test", runtime.NewScheme())
+			c.Stdout = &bytes.Buffer{}
+			c.Stderr = &bytes.Buffer{}
+
+			var since time.Duration
+			tailer := &SternTailer{
+				run: func(ctx context.Context, config *stern.Config) error {
+					since = config.Since
+					return nil
+				},
+			}
+			if err := tailer.Tail(context.Background(), c, "default", labels.Everything(), test.opts); err != nil {
+				t.Fatalf("Tail() errored %v", err)
+			}
+			if diff := cmp.Diff(test.expected, since); diff != "" {
+				t.Errorf("Tail() since (-expected, +actual) = %v", diff)
+			}
+			// stern sends the since seconds to the API server, which rejects them unless positive
+			if int64(since.Seconds()) <= 0 {
+				t.Errorf("Tail() since seconds expected to be positive, got %d", int64(since.Seconds()))
+			}
+		})
+	}
+}
+
+func TestJSONLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		log      stern.Log
+		group    string
+		expected LogLine
+	}{{
+		name: "with timestamp",
+		log: stern.Log{
+			Message:       "2023-01-01T00:00:00.123456789Z Started PetClinicApplication",
+			Namespace:     "default",
+			PodName:       "my-pod",
+			ContainerName: "workload",
+		},
+		expected: LogLine{
+			Pod:       "my-pod",
+			Container: "workload",
+			Namespace: "default",
+			Timestamp: "2023-01-01T00:00:00.123456789Z",
+			Message:   "Started PetClinicApplication",
+		},
+	}, {
+		name: "without timestamp",
+		log: stern.Log{
+			Message:       "Started",
+			Namespace:     "default",
+			PodName:       "my-pod",
+			ContainerName: "workload",
+		},
+		expected: LogLine{
+			Pod:       "my-pod",
+			Container: "workload",
+			Namespace: "default",
+			Message:   "Started",
+		},
+	}, {
+		name: "first word is not a timestamp",
+		log: stern.Log{
+			Message:       "Started PetClinicApplication",
+			Namespace:     "default",
+			PodName:       "my-pod",
+			ContainerName: "workload",
+		},
+		expected: LogLine{
+			Pod:       "my-pod",
+			Container: "workload",
+			Namespace: "default",
+			Message:   "Started PetClinicApplication",
+		},
+	}, {
+		name: "strips ansi colors",
+		log: stern.Log{
+			Message:       "2023-01-01T00:00:00Z \u001b[32mINFO\u001b[0m Started",
+			Namespace:     "default",
+			PodName:       "my-pod",
+			ContainerName: "workload",
+		},
+		expected: LogLine{
+			Pod:       "my-pod",
+			Container: "workload",
+			Namespace: "default",
+			Timestamp: "2023-01-01T00:00:00Z",
+			Message:   "INFO Started",
+		},
+	}, {
+		name: "with group",
+		log: stern.Log{
+			Message:       "Cloning",
+			Namespace:     "default",
+			PodName:       "my-pod",
+			ContainerName: "step-clone",
+		},
+		group: "source-provider",
+		expected: LogLine{
+			Group:     "source-provider",
+			Pod:       "my-pod",
+			Container: "step-clone",
+			Namespace: "default",
+			Message:   "Cloning",
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := jsonLine(test.log, test.group)
+			if err != nil {
+				t.Fatalf("jsonLine() errored %v", err)
+			}
+			actual := LogLine{}
+			if err := json.Unmarshal([]byte(out), &actual); err != nil {
+				t.Fatalf("Unmarshal() errored %v", err)
+			}
+			if diff := cmp.Diff(test.expected, actual); diff != "" {
+				t.Errorf("jsonLine() (-expected, +actual) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestSternTailerTemplate(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = noColor }()
+
+	log := stern.Log{
+		Message:        "2023-01-01T00:00:00Z \u001b[32mStarted\u001b[0m",
+		Namespace:      "default",
+		PodName:        "my-pod",
+		ContainerName:  "workload",
+		PodColor:       color.New(color.FgCyan),
+		ContainerColor: color.New(color.FgCyan),
+	}
+	groups := []PodGroup{{Name: "run", Pods: []string{"my-pod"}}}
+
+	tests := []struct {
+		name       string
+		opts       TailOptions
+		expected   string
+		timestamps bool
+	}{{
+		name:     "default",
+		opts:     TailOptions{},
+		expected: "my-pod[workload] 2023-01-01T00:00:00Z Started\n",
+	}, {
+		name:     "default with group",
+		opts:     TailOptions{PodGroups: groups},
+		expected: "run my-pod[workload] 2023-01-01T00:00:00Z Started\n",
+	}, {
+		name:     "raw",
+		opts:     TailOptions{Output: OutputRaw},
+		expected: "2023-01-01T00:00:00Z Started\n",
+	}, {
+		name:     "raw with group",
+		opts:     TailOptions{Output: OutputRaw, PodGroups: groups},
+		expected: "run 2023-01-01T00:00:00Z Started\n",
+	}, {
+		name:       "json",
+		opts:       TailOptions{Output: OutputJSON, PodGroups: groups},
+		expected:   `{"group":"run","pod":"my-pod","container":"workload","namespace":"default","timestamp":"2023-01-01T00:00:00Z","message":"Started"}` + "\n",
+		timestamps: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := cli.NewDefaultConfig("test", runtime.NewScheme())
+			stdout := &bytes.Buffer{}
+			c.Stdout = stdout
+			c.Stderr = &bytes.Buffer{}
+
+			var timestamps bool
+			tailer := &SternTailer{
+				run: func(ctx context.Context, config *stern.Config) error {
+					timestamps = config.Timestamps
+					return config.Template.Execute(config.Out, log)
+				},
+			}
+			opts := test.opts
+			opts.Follow = true
+			if err := tailer.Tail(context.Background(), c, "default", labels.Everything(), opts); err != nil {
+				t.Fatalf("Tail() errored %v", err)
+			}
+			if diff := cmp.Diff(test.expected, stdout.String()); diff != "" {
+				t.Errorf("Tail() output (-expected, +actual) = %v", diff)
+			}
+			if timestamps != test.timestamps {
+				t.Errorf("Tail() timestamps expected %v, got %v", test.timestamps, timestamps)
+			}
+		})
+	}
+}
+
+func TestSternTailerIncludeExclude(t *testing.T) {
+	c := cli.NewDefaultConfig("test", runtime.NewScheme())
+	c.Stdout = &bytes.Buffer{}
+	c.Stderr = &bytes.Buffer{}
+
+	var include, exclude []string
+	tailer := &SternTailer{
+		run: func(ctx context.Context, config *stern.Config) error {
+			for _, r := range config.Include {
+				include = append(include, r.String())
+			}
+			for _, r := range config.Exclude {
+				exclude = append(exclude, r.String())
+			}
+			return nil
+		},
+	}
+	if err := tailer.Tail(context.Background(), c, "default", labels.Everything(), TailOptions{
+		Follow:  true,
+		Include: []string{"ERROR", "WARN.*"},
+		Exclude: []string{"health"},
+	}); err != nil {
+		t.Fatalf("Tail() errored %v", err)
+	}
+	if diff := cmp.Diff([]string{"ERROR", "WARN.*"}, include); diff != "" {
+		t.Errorf("Tail() include (-expected, +actual) = %v", diff)
+	}
+	if diff := cmp.Diff([]string{"health"}, exclude); diff != "" {
+		t.Errorf("Tail() exclude (-expected, +actual) = %v", diff)
+	}
+
+	for _, opts := range []TailOptions{
+		{Follow: true, Include: []string{"("}},
+		{Follow: true, Exclude: []string{"("}},
+	} {
+		if err := tailer.Tail(context.Background(), c, "default", labels.Everything(), opts); err == nil {
+			t.Errorf("Tail() expected an error for the invalid expression in %+v", opts)
+		}
+	}
+}
+
+func TestRestartedContainers(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init"}, {Name: "init-restarted"}},
+			Containers:     []corev1.Container{{Name: "workload"}, {Name: "queue-proxy"}, {Name: "sidecar"}},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "init", RestartCount: 0},
+				{Name: "init-restarted", RestartCount: 1},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "workload", RestartCount: 3},
+				{Name: "queue-proxy", RestartCount: 0},
+			},
+		},
+	}
+
+	if diff := cmp.Diff([]string{"init-restarted", "workload"}, restartedContainers(pod)); diff != "" {
+		t.Errorf("restartedContainers() (-expected, +actual) = %v", diff)
+	}
+}
+
+func TestPrintRestarts(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = noColor }()
+
+	terminated := corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1},
+	}
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "init", RestartCount: 1, LastTerminationState: terminated},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "never", RestartCount: 0},
+				{Name: "once", RestartCount: 1, LastTerminationState: terminated},
+				{Name: "many", RestartCount: 5, LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+				}},
+				{Name: "unknown", RestartCount: 2},
+			},
+		},
+	}
+	pod.Name = "my-pod"
+
+	tests := []struct {
+		container string
+		expected  string
+	}{{
+		container: "init",
+		expected:  "↻ my-pod › init restarted once, last terminated with Error (exit code 1)\n",
+	}, {
+		container: "never",
+		expected:  "↻ my-pod › never restarted 0 times\n",
+	}, {
+		container: "once",
+		expected:  "↻ my-pod › once restarted once, last terminated with Error (exit code 1)\n",
+	}, {
+		container: "many",
+		expected:  "↻ my-pod › many restarted 5 times, last terminated with OOMKilled (exit code 137)\n",
+	}, {
+		container: "unknown",
+		expected:  "↻ my-pod › unknown restarted 2 times\n",
+	}, {
+		container: "missing",
+		expected:  "",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.container, func(t *testing.T) {
+			c := cli.NewDefaultConfig("test", runtime.NewScheme())
+			stderr := &bytes.Buffer{}
+			c.Stderr = stderr
+
+			printRestarts(c, pod, test.container)
+			if diff := cmp.Diff(test.expected, stderr.String()); diff != "" {
+				t.Errorf("printRestarts() (-expected, +actual) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestGroupPods(t *testing.T) {
+	pod := func(name string) corev1.Pod {
+		p := corev1.Pod{}
+		p.Name = name
+		return p
+	}
+	pods := []corev1.Pod{pod("build-pod"), pod("clone-pod-b"), pod("clone-pod-a"), pod("app-pod")}
+
+	tests := []struct {
+		name     string
+		groups   []PodGroup
+		expected []string
+	}{{
+		name: "in the order of the groups",
+		groups: []PodGroup{
+			{Name: "source-provider", Pods: []string{"clone-pod-b", "clone-pod-a"}},
+			{Name: "image-provider", Pods: []string{"build-pod"}},
+		},
+		expected: []string{"clone-pod-a", "clone-pod-b", "build-pod"},
+	}, {
+		name: "pods missing from the list",
+		groups: []PodGroup{
+			{Name: "image-provider", Pods: []string{"deleted-pod", "build-pod"}},
+		},
+		expected: []string{"build-pod"},
+	}, {
+		name:     "no groups",
+		groups:   []PodGroup{},
+		expected: []string{},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := []string{}
+			for _, pod := range groupPods(pods, test.groups) {
+				actual = append(actual, pod.Name)
+			}
+			if diff := cmp.Diff(test.expected, actual); diff != "" {
+				t.Errorf("groupPods() (-expected, +actual) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestPodsFieldSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		groups   []PodGroup
+		expected string
+	}{{
+		name:     "no groups",
+		expected: "",
+	}, {
+		name:     "single pod",
+		groups:   []PodGroup{{Pods: []string{"my-pod"}}},
+		expected: "metadata.name=my-pod",
+	}, {
+		name:     "several pods",
+		groups:   []PodGroup{{Name: "build", Pods: []string{"build-pod"}}, {Name: "run", Pods: []string{"run-pod"}}},
+		expected: "",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.expected, podsFieldSelector(test.groups).String()); diff != "" {
+				t.Errorf("podsFieldSelector() (-expected, +actual) = %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	OutputFormatWide          = "wide"
+	OutputFormatCustomColumns = "custom-columns"
+	OutputFormatJSONPath      = "jsonpath"
+)
+
+// CustomColumn is a column of the custom-columns output, the value of each cell is found by
+// evaluating the JSONPath expression against the object of the row.
+type CustomColumn struct {
+	Header string
+	Path   *jsonpath.JSONPath
+}
+
+// ParseCustomColumns parses a comma separated list of HEADER:JSONPATH pairs. The JSONPath
+// expressions may omit the surrounding braces, e.g. "NAME:.metadata.name,APP:.metadata.labels.app".
+func ParseCustomColumns(spec string) ([]CustomColumn, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("custom-columns format specified but no custom columns given")
+	}
+	columns := []CustomColumn{}
+	for _, part := range strings.Split(spec, ",") {
+		header, expression, ok := strings.Cut(part, ":")
+		if !ok || header == "" || expression == "" {
+			return nil, fmt.Errorf("unexpected custom-columns spec %q, expected <header>:<json-path-expr>", part)
+		}
+		path, err := ParseJSONPath(header, relaxedJSONPathExpression(expression))
+		if err != nil {
+			return nil, err
+		}
+		path.AllowMissingKeys(true)
+		columns = append(columns, CustomColumn{Header: header, Path: path})
+	}
+	return columns, nil
+}
+
+// Value returns the content of the column for the object, "<none>" when the expression does not
+// match any field.
+func (c CustomColumn) Value(obj runtime.Object) (string, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return "", err
+	}
+	results, err := c.Path.FindResults(u)
+	if err != nil {
+		return "", err
+	}
+	values := []string{}
+	for _, result := range results {
+		for _, value := range result {
+			values = append(values, fmt.Sprintf("%v", value.Interface()))
+		}
+	}
+	if len(values) == 0 {
+		return "<none>", nil
+	}
+	return strings.Join(values, ","), nil
+}
+
+// ParseJSONPath parses a JSONPath template, e.g. "{.metadata.name}".
+func ParseJSONPath(name, template string) (*jsonpath.JSONPath, error) {
+	path := jsonpath.New(name)
+	if err := path.Parse(template); err != nil {
+		return nil, fmt.Errorf("error parsing jsonpath %s: %w", template, err)
+	}
+	return path, nil
+}
+
+// OutputJSONPath evaluates the JSONPath template against a list holding the objects, the same way
+// as "kubectl get -o jsonpath" does, e.g. "{.items[*].metadata.name}".
+func OutputJSONPath(objList []Object, template string, scheme *runtime.Scheme) (string, error) {
+	path, err := ParseJSONPath(OutputFormatJSONPath, template)
+	if err != nil {
+		return "", err
+	}
+	items := []interface{}{}
+	for _, o := range objList {
+		copy, err := setGVK(o, scheme)
+		if err != nil {
+			return "", err
+		}
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(copy)
+		if err != nil {
+			return "", err
+		}
+		items = append(items, u)
+	}
+	list := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      items,
+	}
+	var buf bytes.Buffer
+	if err := path.Execute(&buf, list); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// relaxedJSONPathExpression adds the braces and leading dot to an expression like kubectl does for
+// custom columns, e.g. "metadata.name" becomes "{.metadata.name}".
+func relaxedJSONPathExpression(expression string) string {
+	if strings.HasPrefix(expression, "{") {
+		return expression
+	}
+	return fmt.Sprintf("{.%s}", strings.TrimPrefix(expression, "."))
+}
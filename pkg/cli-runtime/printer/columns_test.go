@@ -0,0 +1,167 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+)
+
+func TestCustomColumns(t *testing.T) {
+	workload := &cartov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-workload",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "my-app",
+			},
+		},
+		Spec: cartov1alpha1.WorkloadSpec{
+			Env: []corev1.EnvVar{{Name: "FOO"}, {Name: "BAR"}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		spec        string
+		headers     []string
+		values      []string
+		shouldError bool
+	}{{
+		name:    "single column",
+		spec:    "NAME:.metadata.name",
+		headers: []string{"NAME"},
+		values:  []string{"my-workload"},
+	}, {
+		name:    "relaxed expressions",
+		spec:    "NAME:metadata.name,APP:{.metadata.labels.app\\.kubernetes\\.io/part-of}",
+		headers: []string{"NAME", "APP"},
+		values:  []string{"my-workload", "my-app"},
+	}, {
+		name:    "multiple values",
+		spec:    "ENV:.spec.env[*].name",
+		headers: []string{"ENV"},
+		values:  []string{"FOO,BAR"},
+	}, {
+		name:    "missing field",
+		spec:    "IMAGE:.spec.image",
+		headers: []string{"IMAGE"},
+		values:  []string{"<none>"},
+	}, {
+		name:        "empty spec",
+		spec:        "",
+		shouldError: true,
+	}, {
+		name:        "missing expression",
+		spec:        "NAME",
+		shouldError: true,
+	}, {
+		name:        "invalid expression",
+		spec:        "NAME:{.metadata.name",
+		shouldError: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			columns, err := printer.ParseCustomColumns(test.spec)
+			if err != nil {
+				if !test.shouldError {
+					t.Errorf("ParseCustomColumns() errored %v", err)
+				}
+				return
+			}
+			if test.shouldError {
+				t.Fatalf("ParseCustomColumns() expected error")
+			}
+			headers := []string{}
+			values := []string{}
+			for _, column := range columns {
+				headers = append(headers, column.Header)
+				value, err := column.Value(workload)
+				if err != nil {
+					t.Fatalf("Value() errored %v", err)
+				}
+				values = append(values, value)
+			}
+			if diff := cmp.Diff(test.headers, headers); diff != "" {
+				t.Errorf("ParseCustomColumns() headers (-expected, +actual) = %v", diff)
+			}
+			if diff := cmp.Diff(test.values, values); diff != "" {
+				t.Errorf("Value() (-expected, +actual) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestOutputJSONPath(t *testing.T) {
+	scheme := runtime.NewScheme()
+	cartov1alpha1.AddToScheme(scheme)
+
+	workloads := []printer.Object{
+		&cartov1alpha1.Workload{ObjectMeta: metav1.ObjectMeta{Name: "petclinic"}},
+		&cartov1alpha1.Workload{ObjectMeta: metav1.ObjectMeta{Name: "spring-pet-clinic"}},
+	}
+
+	tests := []struct {
+		name        string
+		template    string
+		objs        []printer.Object
+		want        string
+		shouldError bool
+	}{{
+		name:     "names",
+		template: "{.items[*].metadata.name}",
+		objs:     workloads,
+		want:     "petclinic spring-pet-clinic",
+	}, {
+		name:     "range",
+		template: "{range .items[*]}{.kind}/{.metadata.name}{\"\\n\"}{end}",
+		objs:     workloads,
+		want:     "Workload/petclinic\nWorkload/spring-pet-clinic\n",
+	}, {
+		name:     "empty list",
+		template: "{.kind}",
+		want:     "List",
+	}, {
+		name:        "invalid template",
+		template:    "{.items[*]",
+		objs:        workloads,
+		shouldError: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := printer.OutputJSONPath(test.objs, test.template, scheme)
+			if err != nil {
+				if !test.shouldError {
+					t.Errorf("OutputJSONPath() errored %v", err)
+				}
+				return
+			}
+			if test.shouldError {
+				t.Fatalf("OutputJSONPath() expected error")
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("OutputJSONPath() (-expected, +actual) = %v", diff)
+			}
+		})
+	}
+}
@@ -57,6 +57,23 @@ func ConditionStatus(cond *metav1.Condition) string {
 	}
 }
 
+// ConditionSymbol is a compact form of ConditionStatus for places where a word per condition does
+// not fit: a check mark when the condition is True, a cross when False, an ellipsis while Unknown
+// and a dash when there is no status yet.
+func ConditionSymbol(cond *metav1.Condition) string {
+	if cond == nil || cond.Status == "" {
+		return Sfaintf("–")
+	}
+	switch cond.Status {
+	case metav1.ConditionTrue:
+		return Ssuccessf("✔")
+	case metav1.ConditionFalse:
+		return Serrorf("✘")
+	default:
+		return Sinfof("…")
+	}
+}
+
 func ColorConditionStatus(condStatus string) string {
 	switch condStatus {
 	case "True", "true":
@@ -138,6 +138,57 @@ func TestConditionStatus(t *testing.T) {
 	}
 }
 
+func TestConditionSymbol(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = noColor }()
+
+	tests := []struct {
+		name   string
+		input  *metav1.Condition
+		output string
+	}{{
+		name:   "empty",
+		output: "–",
+	}, {
+		name: "no status",
+		input: &metav1.Condition{
+			Type: "Ready",
+		},
+		output: "–",
+	}, {
+		name: "status true",
+		input: &metav1.Condition{
+			Type:   "Ready",
+			Status: metav1.ConditionTrue,
+		},
+		output: "✔",
+	}, {
+		name: "status false",
+		input: &metav1.Condition{
+			Type:   "Ready",
+			Status: metav1.ConditionFalse,
+			Reason: "uh-oh",
+		},
+		output: "✘",
+	}, {
+		name: "status unknown",
+		input: &metav1.Condition{
+			Type:   "Ready",
+			Status: metav1.ConditionUnknown,
+		},
+		output: "…",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if expected, actual := test.output, printer.ConditionSymbol(test.input); expected != actual {
+				t.Errorf("Expected formated string to be %q, actually %q", expected, actual)
+			}
+		})
+	}
+}
+
 func TestColorConditionStatus(t *testing.T) {
 	noColor := color.NoColor
 	color.NoColor = true
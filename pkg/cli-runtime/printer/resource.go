@@ -112,6 +112,12 @@ func OutputResources(objList []Object, format OutputFormat, scheme *runtime.Sche
 	return printObject(updatedList, format)
 }
 
+// OutputObject renders any value that can be marshaled, like a report aggregating several
+// resources, in the output format.
+func OutputObject(obj interface{}, format OutputFormat) (string, error) {
+	return printObject(obj, format)
+}
+
 func printObject(obj interface{}, format OutputFormat) (string, error) {
 	// render according to desired format
 	switch format {
@@ -18,6 +18,7 @@ package testing
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/cli-runtime/pkg/resource"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/disk"
@@ -58,6 +60,17 @@ func (c *fakeclient) SetLogger(logger logr.Logger) {
 	panic(fmt.Errorf("not implemented"))
 }
 
+// Patch records apply patches without applying them, since the fake client tracker does not
+// support server-side apply and faking its merge would hide differences with a real server. The
+// patch is passed through the reactors and can be asserted with ExpectPatches, while the object
+// and the tracker are left unchanged.
+func (c *fakeclient) Patch(ctx context.Context, obj crclient.Object, patch crclient.Patch, opts ...crclient.PatchOption) error {
+	if patch.Type() != types.ApplyPatchType {
+		return c.Client.Patch(ctx, obj, patch, opts...)
+	}
+	return c.Client.Patch(ctx, obj, patch, append(opts, crclient.DryRunAll)...)
+}
+
 func NewFakeCliClient(c crclient.Client) cli.Client {
 	return &fakeclient{
 		defaultNamespace: "default",
@@ -140,6 +140,9 @@ type CommandTestCase struct {
 	// instead. The Group will be blank for 'core' resources. The Resource is not a Kind, but
 	// plural lowercase name of the resource.
 	ExpectDeletes []rtesting.DeleteRef
+	// ExpectPatches asserts references to the Patch method of the fake client in order. The
+	// reference contains the patch type and the raw patch sent to the client.
+	ExpectPatches []rtesting.PatchRef
 	// ExpectDeleteCollections asserts references to the DeleteCollection method of the fake
 	// client in order. DeleteCollections behaves similarly to Deletes. Unlike Delete,
 	// DeleteCollection does not contain a resource Name, but may contain a LabelSelector.
@@ -212,6 +215,7 @@ func (tc CommandTestCase) Run(t *testing.T, scheme *k8sruntime.Scheme, cmdFactor
 			ExpectCreates:           tc.ExpectCreates,
 			ExpectUpdates:           tc.ExpectUpdates,
 			ExpectDeletes:           tc.ExpectDeletes,
+			ExpectPatches:           tc.ExpectPatches,
 			ExpectDeleteCollections: tc.ExpectDeleteCollections,
 		}
 
@@ -19,7 +19,10 @@ package fake
 import (
 	"context"
 	"fmt"
+	"strconv"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -43,9 +46,14 @@ func (c *FakeWithWatch) Watch(ctx context.Context, list client.ObjectList, opts
 	if c.err {
 		return nil, fmt.Errorf("failed to create watcher")
 	}
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
 	watcher := watch.NewRaceFreeFake()
 	go func() {
 		for _, event := range c.events {
+			if !after(event, listOpts) || !selected(event, listOpts) {
+				continue
+			}
 			if !watcher.IsStopped() {
 				switch event.Type {
 				case watch.Added:
@@ -62,3 +70,40 @@ func (c *FakeWithWatch) Watch(ctx context.Context, list client.ObjectList, opts
 	}()
 	return watcher, nil
 }
+
+// after tells if the event happened after the resource version the watch starts at, like the API
+// server does. Events for objects without a resource version are always sent.
+func after(event watch.Event, listOpts *client.ListOptions) bool {
+	if listOpts.Raw == nil || listOpts.Raw.ResourceVersion == "" {
+		return true
+	}
+	obj, err := meta.Accessor(event.Object)
+	if err != nil {
+		return true
+	}
+	start, err := strconv.ParseUint(listOpts.Raw.ResourceVersion, 10, 64)
+	if err != nil {
+		return true
+	}
+	resourceVersion, err := strconv.ParseUint(obj.GetResourceVersion(), 10, 64)
+	if err != nil {
+		return true
+	}
+	return resourceVersion > start
+}
+
+// selected tells if the object of the event matches the name and namespace fields of the field
+// selector the watch was created with, like the API server does.
+func selected(event watch.Event, listOpts *client.ListOptions) bool {
+	if listOpts.FieldSelector == nil || listOpts.FieldSelector.Empty() {
+		return true
+	}
+	obj, err := meta.Accessor(event.Object)
+	if err != nil {
+		return true
+	}
+	return listOpts.FieldSelector.Matches(fields.Set{
+		"metadata.name":      obj.GetName(),
+		"metadata.namespace": obj.GetNamespace(),
+	})
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+type ClusterDeliveryGetOptions struct {
+	Name string
+}
+
+var (
+	_ validation.Validatable = (*ClusterDeliveryGetOptions)(nil)
+	_ cli.Executable         = (*ClusterDeliveryGetOptions)(nil)
+)
+
+func (opts *ClusterDeliveryGetOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+	return errs
+}
+
+func (opts *ClusterDeliveryGetOptions) Exec(ctx context.Context, c *cli.Config) error {
+	delivery := &cartov1alpha1.ClusterDelivery{}
+	err := c.Get(ctx, client.ObjectKey{Name: opts.Name}, delivery)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			c.Errorf("Cluster delivery %q not found\n", opts.Name)
+			return cli.SilenceError(err)
+		}
+		return err
+	}
+	c.Printf(printer.ResourceStatus(delivery.Name, printer.FindCondition(delivery.Status.Conditions, cartov1alpha1.DeliveryReady)))
+
+	c.Boldf("Delivery Selectors\n")
+	if len(delivery.Spec.Selector) == 0 && len(delivery.Spec.SelectorMatchExpressions) == 0 && len(delivery.Spec.SelectorMatchFields) == 0 {
+		c.Infof("No delivery selectors found\n")
+	} else {
+		if err := printer.ClusterDeliveryPrinter(c.Stdout, delivery); err != nil {
+			return err
+		}
+	}
+
+	c.Printf("\n")
+	c.Boldf("Delivery Resources\n")
+	if len(delivery.Spec.Resources) == 0 {
+		c.Infof("No delivery resources found\n")
+	} else {
+		if err := printer.ClusterDeliveryResourcesPrinter(c.Stdout, delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewClusterDeliveryGetCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &ClusterDeliveryGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get details from a cluster delivery",
+		Long:  strings.TrimSpace(`Get details from a cluster delivery`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s cluster-delivery get", c.Name),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestClusterDeliveryNames(ctx, c),
+	}
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	return cmd
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	diemetav1 "dies.dev/apis/meta/v1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+)
+
+func TestClusterDeliveryGetOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "invalid empty",
+			Validatable: &commands.ClusterDeliveryGetOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.ClusterDeliveryGetOptions{
+				Name: "my-delivery",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid name",
+			Validatable: &commands.ClusterDeliveryGetOptions{
+				Name: "my-",
+			},
+			ShouldValidate: true,
+		},
+	}
+	table.Run(t)
+}
+
+func TestClusterDeliveryGetCommand(t *testing.T) {
+	deliveryName := "test-delivery"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+
+	parent := diecartov1alpha1.ClusterDeliveryBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(deliveryName)
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "invalid args",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "valid",
+			Args:         []string{deliveryName},
+			GivenObjects: []client.Object{parent},
+			ExpectOutput: `
+---
+# test-delivery: <unknown>
+---
+Delivery Selectors
+No delivery selectors found
+
+Delivery Resources
+No delivery resources found
+`,
+		}, {
+			Name: "label selectors",
+			Args: []string{deliveryName},
+			GivenObjects: []client.Object{parent.
+				SpecDie(func(d *diecartov1alpha1.DeliverySpecDie) {
+					d.Selector(map[string]string{
+						"app.tanzu.vmware.com/deliverable-type":    "web",
+						"app.tanzu.vmware.com/deliverable-cluster": "test",
+					})
+				},
+				)},
+			ExpectOutput: `
+---
+# test-delivery: <unknown>
+---
+Delivery Selectors
+   TYPE     KEY                                        OPERATOR   VALUE
+   labels   app.tanzu.vmware.com/deliverable-cluster              test
+   labels   app.tanzu.vmware.com/deliverable-type                 web
+
+Delivery Resources
+No delivery resources found
+`,
+		}, {
+			Name: "all selectors",
+			Args: []string{deliveryName},
+			GivenObjects: []client.Object{parent.
+				SpecDie(func(d *diecartov1alpha1.DeliverySpecDie) {
+					d.Selector(map[string]string{
+						"app.tanzu.vmware.com/deliverable-type": "web",
+					})
+					d.SelectorMatchFields(
+						cartov1alpha1.FieldSelectorRequirement{
+							Key:      "spec.image",
+							Operator: cartov1alpha1.FieldSelectorOperator("Exists"),
+						})
+					d.SelectorMatchExpressions(
+						metav1.LabelSelectorRequirement{
+							Key:      "foo",
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{"bar"},
+						})
+				},
+				)},
+
+			ExpectOutput: `
+---
+# test-delivery: <unknown>
+---
+Delivery Selectors
+   TYPE          KEY                                     OPERATOR   VALUE
+   labels        app.tanzu.vmware.com/deliverable-type              web
+   fields        spec.image                              Exists
+   expressions   foo                                     In         bar
+
+Delivery Resources
+No delivery resources found
+`,
+		}, {
+			Name: "resources",
+			Args: []string{deliveryName},
+			GivenObjects: []client.Object{parent.
+				StatusDie(func(d *diecartov1alpha1.DeliveryStatusDie) {
+					d.ConditionsDie(
+						diecartov1alpha1.ClusterDeliveryConditionReadyBlank.Status(metav1.ConditionTrue),
+					)
+				}).
+				SpecDie(func(d *diecartov1alpha1.DeliverySpecDie) {
+					d.Selector(map[string]string{
+						"app.tanzu.vmware.com/deliverable-type": "web",
+					})
+					d.Resources(
+						cartov1alpha1.DeliveryResource{
+							Name: "source-provider",
+							TemplateRef: cartov1alpha1.DeliveryTemplateReference{
+								Kind: "ClusterSourceTemplate",
+								Name: "delivery-source-template",
+							},
+						},
+						cartov1alpha1.DeliveryResource{
+							Name: "deployer",
+							TemplateRef: cartov1alpha1.DeliveryTemplateReference{
+								Kind: "ClusterDeploymentTemplate",
+								Name: "app-deploy",
+							},
+							Deployment: &cartov1alpha1.DeploymentReference{
+								Resource: "source-provider",
+							},
+						},
+					)
+				},
+				)},
+			ExpectOutput: `
+---
+# test-delivery: Ready
+---
+Delivery Selectors
+   TYPE     KEY                                     OPERATOR   VALUE
+   labels   app.tanzu.vmware.com/deliverable-type              web
+
+Delivery Resources
+   NAME              TEMPLATE KIND               TEMPLATE NAME
+   source-provider   ClusterSourceTemplate       delivery-source-template
+   deployer          ClusterDeploymentTemplate   app-deploy
+`,
+		}, {
+			Name: "not found",
+			Args: []string{deliveryName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "ClusterDelivery", clitesting.InduceFailureOpts{
+					Error: apierrors.NewNotFound(cartov1alpha1.Resource("ClusterDelivery"), deliveryName),
+				}),
+			},
+			ExpectOutput: `
+Cluster delivery "test-delivery" not found
+`,
+			ShouldError: true,
+		}, {
+			Name: "get error",
+			Args: []string{deliveryName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "ClusterDelivery"),
+			},
+			ShouldError: true,
+		},
+	}
+	table.Run(t, scheme, commands.NewClusterDeliveryGetCommand)
+}
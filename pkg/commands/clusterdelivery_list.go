@@ -0,0 +1,137 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+)
+
+type ClusterDeliveryListOptions struct {
+	// none for now
+}
+
+var (
+	_ validation.Validatable = (*ClusterDeliveryListOptions)(nil)
+	_ cli.Executable         = (*ClusterDeliveryListOptions)(nil)
+)
+
+func (opts *ClusterDeliveryListOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	// none for now
+
+	return errs
+}
+
+func (opts *ClusterDeliveryListOptions) Exec(ctx context.Context, c *cli.Config) error {
+	delivery := &cartov1alpha1.ClusterDeliveryList{}
+	err := c.List(ctx, delivery)
+	if err != nil {
+		return err
+	}
+
+	if len(delivery.Items) == 0 {
+		c.Infof("No cluster deliveries found.\n")
+		return nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{
+		// none for now
+	}).With(func(h table.PrintHandler) {
+		columns := opts.printColumns()
+		h.TableHandler(columns, opts.printList)
+		h.TableHandler(columns, opts.print)
+	})
+
+	delivery = delivery.DeepCopy()
+	printer.SortByNamespaceAndName(delivery.Items)
+
+	if err := tablePrinter.PrintObj(delivery, c.Stdout); err != nil {
+		return err
+	}
+
+	c.Printf("\n")
+	c.Infof("To view details: \"tanzu apps cluster-delivery get <name>\"\n")
+	c.Printf("\n")
+
+	return nil
+}
+
+func NewClusterDeliveryListCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &ClusterDeliveryListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "table listing of cluster deliveries",
+		Long: strings.TrimSpace(`
+List cluster deliveries.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s cluster-delivery list", c.Name),
+		}, "\n"),
+		PreRunE: cli.ValidateE(ctx, opts),
+		RunE:    cli.ExecE(ctx, c, opts),
+	}
+
+	return cmd
+}
+
+func (opts *ClusterDeliveryListOptions) printList(deliveries *cartov1alpha1.ClusterDeliveryList, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(deliveries.Items))
+	for i := range deliveries.Items {
+		r, err := opts.print(&deliveries.Items[i], printOpts)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func (opts *ClusterDeliveryListOptions) print(delivery *cartov1alpha1.ClusterDelivery, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+	now := time.Now()
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: delivery},
+	}
+	row.Cells = append(row.Cells,
+		delivery.Name,
+		printer.ConditionStatus(printer.FindCondition(delivery.Status.Conditions, "Ready")),
+		printer.TimestampSince(delivery.CreationTimestamp, now),
+	)
+	return []metav1beta1.TableRow{row}, nil
+}
+
+func (opts *ClusterDeliveryListOptions) printColumns() []metav1beta1.TableColumnDefinition {
+	return []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string"},
+		{Name: "Ready", Type: "string"},
+		{Name: "Age", Type: "string"},
+	}
+}
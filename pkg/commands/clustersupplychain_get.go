@@ -23,17 +23,23 @@ import (
 
 	"github.com/spf13/cobra"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
 )
 
 type ClusterSupplyChainGetOptions struct {
-	Name string
+	Name             string
+	Output           string
+	ShowTemplates    bool
+	ShowTemplateBody bool
 }
 
 var (
@@ -46,6 +52,15 @@ func (opts *ClusterSupplyChainGetOptions) Validate(ctx context.Context) validati
 	if opts.Name == "" {
 		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
 	}
+	if opts.ShowTemplateBody && !opts.ShowTemplates {
+		errs = errs.Also(validation.ErrMissingField(flags.ShowTemplatesFlagName))
+	}
+	if opts.Output != "" {
+		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.GraphFormatDot, printer.GraphFormatMermaid}))
+		if opts.ShowTemplates {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.OutputFlagName, flags.ShowTemplatesFlagName))
+		}
+	}
 	return errs
 }
 
@@ -59,6 +74,11 @@ func (opts *ClusterSupplyChainGetOptions) Exec(ctx context.Context, c *cli.Confi
 		}
 		return err
 	}
+
+	if opts.Output != "" {
+		return printer.GraphPrinter(c.Stdout, opts.Output, supplyChain.Name, printer.ClusterSupplyChainGraph(supplyChain))
+	}
+
 	c.Printf(printer.ResourceStatus(supplyChain.Name, printer.FindCondition(supplyChain.Status.Conditions, cartov1alpha1.SupplyChainReady)))
 
 	c.Boldf("Supply Chain Selectors\n")
@@ -69,8 +89,83 @@ func (opts *ClusterSupplyChainGetOptions) Exec(ctx context.Context, c *cli.Confi
 			return err
 		}
 	}
+
+	if opts.ShowTemplates {
+		if err := opts.printTemplates(ctx, c, supplyChain); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// printTemplates fetches the template of each supply chain resource and prints their status,
+// their params and, when asked for, their body.
+func (opts *ClusterSupplyChainGetOptions) printTemplates(ctx context.Context, c *cli.Config, supplyChain *cartov1alpha1.ClusterSupplyChain) error {
+	templates := map[string]*unstructured.Unstructured{}
+	for _, resource := range supplyChain.Spec.Resources {
+		template := resource.TemplateRef.Template()
+		if err := c.Get(ctx, client.ObjectKey{Name: resource.TemplateRef.Name}, template); err != nil {
+			if !apierrs.IsNotFound(err) && !meta.IsNoMatchError(err) {
+				return err
+			}
+			template = nil
+		}
+		templates[resource.Name] = template
+	}
+
+	c.Printf("\n")
+	c.Boldf("Supply Chain Templates\n")
+	if len(supplyChain.Spec.Resources) == 0 {
+		c.Infof("No supply chain resources found\n")
+		return nil
+	}
+	if err := printer.ClusterSupplyChainTemplatesPrinter(c.Stdout, supplyChain, templates); err != nil {
+		return err
+	}
+
+	c.Printf("\n")
+	c.Boldf("Template Params\n")
+	hasParams := false
+	for _, template := range templates {
+		if template == nil {
+			continue
+		}
+		if params, _, _ := unstructured.NestedSlice(template.Object, "spec", "params"); len(params) != 0 {
+			hasParams = true
+			break
+		}
+	}
+	if !hasParams {
+		c.Infof("No template params found\n")
+	} else {
+		if err := printer.ClusterSupplyChainTemplateParamsPrinter(c.Stdout, supplyChain, templates); err != nil {
+			return err
+		}
+	}
+
+	if opts.ShowTemplateBody {
+		for _, resource := range supplyChain.Spec.Resources {
+			template := templates[resource.Name]
+			if template == nil {
+				continue
+			}
+			c.Printf("\n")
+			c.Boldf("Template %s/%s (%s)\n", resource.TemplateRef.Kind, resource.TemplateRef.Name, resource.Name)
+			if err := printer.TemplateBodyPrinter(c.Stdout, template); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, resource := range supplyChain.Spec.Resources {
+		if templates[resource.Name] == nil {
+			c.Printf("\n")
+			c.Errorf("Template %s %q of resource %q not found\n", resource.TemplateRef.Kind, resource.TemplateRef.Name, resource.Name)
+		}
+	}
+	return nil
+}
+
 func NewClusterSupplyChainGetCommand(ctx context.Context, c *cli.Config) *cobra.Command {
 	opts := &ClusterSupplyChainGetOptions{}
 
@@ -79,7 +174,10 @@ func NewClusterSupplyChainGetCommand(ctx context.Context, c *cli.Config) *cobra.
 		Short: "Get details from a cluster supply chain",
 		Long:  strings.TrimSpace(`Get details from a cluster supply chain`),
 		Example: strings.Join([]string{
-			fmt.Sprintf("%s cluster-supply-chain get", c.Name),
+			fmt.Sprintf("%s cluster-supply-chain get source-to-url", c.Name),
+			fmt.Sprintf("%s cluster-supply-chain get source-to-url %s", c.Name, flags.ShowTemplatesFlagName),
+			fmt.Sprintf("%s cluster-supply-chain get source-to-url %s %s", c.Name, flags.ShowTemplatesFlagName, flags.ShowTemplateBodyFlagName),
+			fmt.Sprintf("%s cluster-supply-chain get source-to-url %s %s", c.Name, flags.OutputFlagName, printer.GraphFormatDot),
 		}, "\n"),
 		PreRunE:           cli.ValidateE(ctx, opts),
 		RunE:              cli.ExecE(ctx, c, opts),
@@ -89,5 +187,9 @@ func NewClusterSupplyChainGetCommand(ctx context.Context, c *cli.Config) *cobra.
 		cli.NameArg(&opts.Name),
 	)
 
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the graph of the supply chain resources. Supported formats: \"dot\", \"mermaid\"")
+	cmd.Flags().BoolVar(&opts.ShowTemplates, cli.StripDash(flags.ShowTemplatesFlagName), false, "show the template of each supply chain resource, its status and params")
+	cmd.Flags().BoolVar(&opts.ShowTemplateBody, cli.StripDash(flags.ShowTemplateBodyFlagName), false, "show the body of each template, requires "+flags.ShowTemplatesFlagName)
+
 	return cmd
 }
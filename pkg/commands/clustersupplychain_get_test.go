@@ -21,6 +21,7 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
 	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
 )
 
 func TestSupplyChainGetOptionsValidate(t *testing.T) {
@@ -57,6 +59,48 @@ func TestSupplyChainGetOptionsValidate(t *testing.T) {
 			},
 			ShouldValidate: true,
 		},
+		{
+			Name: "show templates with body",
+			Validatable: &commands.ClusterSupplyChainGetOptions{
+				Name:             "my-csc",
+				ShowTemplates:    true,
+				ShowTemplateBody: true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "template body without templates",
+			Validatable: &commands.ClusterSupplyChainGetOptions{
+				Name:             "my-csc",
+				ShowTemplateBody: true,
+			},
+			ExpectFieldErrors: validation.ErrMissingField(flags.ShowTemplatesFlagName),
+		},
+		{
+			Name: "graph output",
+			Validatable: &commands.ClusterSupplyChainGetOptions{
+				Name:   "my-csc",
+				Output: "dot",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.ClusterSupplyChainGetOptions{
+				Name:   "my-csc",
+				Output: "json",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("json", flags.OutputFlagName, []string{"dot", "mermaid"}),
+		},
+		{
+			Name: "graph output with templates",
+			Validatable: &commands.ClusterSupplyChainGetOptions{
+				Name:          "my-csc",
+				Output:        "mermaid",
+				ShowTemplates: true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.OutputFlagName, flags.ShowTemplatesFlagName),
+		},
 	}
 	table.Run(t)
 }
@@ -71,6 +115,63 @@ func TestClusterSupplyChainGetCommand(t *testing.T) {
 		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
 			d.Name(supplyChainName)
 		})
+	withResources := parent.
+		SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+			d.Resources(
+				cartov1alpha1.SupplyChainResource{
+					Name: "source-provider",
+					TemplateRef: cartov1alpha1.SupplyChainTemplateReference{
+						Kind: "ClusterSourceTemplate",
+						Name: "source-template",
+					},
+				},
+				cartov1alpha1.SupplyChainResource{
+					Name: "image-builder",
+					TemplateRef: cartov1alpha1.SupplyChainTemplateReference{
+						Kind: "ClusterImageTemplate",
+						Name: "kpack-template",
+					},
+					Sources: []cartov1alpha1.ResourceReference{{
+						Name:     "source",
+						Resource: "source-provider",
+					}},
+				},
+			)
+		})
+	sourceTemplate := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "carto.run/v1alpha1",
+			"kind":       "ClusterSourceTemplate",
+			"metadata": map[string]interface{}{
+				"name": "source-template",
+			},
+			"spec": map[string]interface{}{
+				"params": []interface{}{
+					map[string]interface{}{
+						"name":    "gitImplementation",
+						"default": "go-git",
+					},
+					map[string]interface{}{
+						"name": "gitops_ssh_secret",
+					},
+				},
+				"template": map[string]interface{}{
+					"apiVersion": "source.toolkit.fluxcd.io/v1beta1",
+					"kind":       "GitRepository",
+				},
+			},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{
+						"type":               "Ready",
+						"status":             "True",
+						"reason":             "Ready",
+						"lastTransitionTime": "2019-06-29T01:44:05Z",
+					},
+				},
+			},
+		},
+	}
 
 	table := clitesting.CommandTestSuite{
 		{
@@ -141,6 +242,106 @@ Supply Chain Selectors
    fields        spec.image                            Exists
    expressions   foo                                   In         bar
 `,
+		}, {
+			Name:         "show templates",
+			Args:         []string{supplyChainName, flags.ShowTemplatesFlagName},
+			GivenObjects: []client.Object{withResources, sourceTemplate},
+			ExpectOutput: `
+---
+# test-supply-chain: <unknown>
+---
+Supply Chain Selectors
+No supply chain selectors found
+
+Supply Chain Templates
+   NAME              TEMPLATE KIND           TEMPLATE NAME     READY
+   source-provider   ClusterSourceTemplate   source-template   Ready
+   image-builder     ClusterImageTemplate    kpack-template    not found
+
+Template Params
+   RESOURCE          NAME                DEFAULT
+   source-provider   gitImplementation   "go-git"
+   source-provider   gitops_ssh_secret   <empty>
+
+Template ClusterImageTemplate "kpack-template" of resource "image-builder" not found
+`,
+		}, {
+			Name:         "show templates with body",
+			Args:         []string{supplyChainName, flags.ShowTemplatesFlagName, flags.ShowTemplateBodyFlagName},
+			GivenObjects: []client.Object{withResources, sourceTemplate},
+			ExpectOutput: `
+---
+# test-supply-chain: <unknown>
+---
+Supply Chain Selectors
+No supply chain selectors found
+
+Supply Chain Templates
+   NAME              TEMPLATE KIND           TEMPLATE NAME     READY
+   source-provider   ClusterSourceTemplate   source-template   Ready
+   image-builder     ClusterImageTemplate    kpack-template    not found
+
+Template Params
+   RESOURCE          NAME                DEFAULT
+   source-provider   gitImplementation   "go-git"
+   source-provider   gitops_ssh_secret   <empty>
+
+Template ClusterSourceTemplate/source-template (source-provider)
+   apiVersion: source.toolkit.fluxcd.io/v1beta1
+   kind: GitRepository
+
+Template ClusterImageTemplate "kpack-template" of resource "image-builder" not found
+`,
+		}, {
+			Name:         "dot graph",
+			Args:         []string{supplyChainName, flags.OutputFlagName, "dot"},
+			GivenObjects: []client.Object{withResources},
+			ExpectOutput: `
+digraph "test-supply-chain" {
+  rankdir="LR";
+  node [shape="box", style="rounded,filled"];
+  "source-provider" [fillcolor="#d3d3d3"];
+  "image-builder" [fillcolor="#d3d3d3"];
+  "source-provider" -> "image-builder";
+}
+`,
+		}, {
+			Name:         "mermaid graph",
+			Args:         []string{supplyChainName, flags.OutputFlagName, "mermaid"},
+			GivenObjects: []client.Object{withResources},
+			ExpectOutput: `
+flowchart LR
+  n0["source-provider"]
+  n1["image-builder"]
+  n0 --> n1
+  classDef ready fill:#a6e3a1
+  classDef notready fill:#f38ba8
+  classDef unknown fill:#d3d3d3
+  class n0 unknown
+  class n1 unknown
+`,
+		}, {
+			Name:         "show templates without resources",
+			Args:         []string{supplyChainName, flags.ShowTemplatesFlagName},
+			GivenObjects: []client.Object{parent},
+			ExpectOutput: `
+---
+# test-supply-chain: <unknown>
+---
+Supply Chain Selectors
+No supply chain selectors found
+
+Supply Chain Templates
+No supply chain resources found
+`,
+		}, {
+			Name:         "show templates get error",
+			Args:         []string{supplyChainName, flags.ShowTemplatesFlagName},
+			GivenObjects: []client.Object{withResources, sourceTemplate},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "ClusterSourceTemplate"),
+			},
+			ShouldError: true,
 		}, {
 			Name: "not found",
 			Args: []string{supplyChainName},
@@ -0,0 +1,44 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+)
+
+func NewDeliverableCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deliverable",
+		Short: "Deliverable lifecycle management",
+		Long: strings.TrimSpace(`
+A deliverable takes the configuration produced for a workload, from a git repository or an image, and deploys it with a cluster delivery. Run clusters usually hold deliverables without the workloads they were built from.
+`),
+		Aliases: []string{"deliverables", "dlv"},
+	}
+
+	cmd.AddCommand(NewDeliverableListCommand(ctx, c))
+	cmd.AddCommand(NewDeliverableGetCommand(ctx, c))
+	cmd.AddCommand(NewDeliverableTailCommand(ctx, c))
+	cmd.AddCommand(NewDeliverableDeleteCommand(ctx, c))
+
+	return cmd
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/wait"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+type DeliverableDeleteOptions struct {
+	Namespace string
+	Names     []string
+	All       bool
+
+	Wait        bool
+	WaitTimeout time.Duration
+	Yes         bool
+}
+
+var (
+	_ validation.Validatable = (*DeliverableDeleteOptions)(nil)
+	_ cli.Executable         = (*DeliverableDeleteOptions)(nil)
+)
+
+func (opts *DeliverableDeleteOptions) Validate(_ context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.All && len(opts.Names) != 0 {
+		errs = errs.Also(validation.ErrMultipleOneOf(flags.AllFlagName, cli.NamesArgumentName))
+	}
+
+	if !opts.All && len(opts.Names) == 0 {
+		errs = errs.Also(validation.ErrMissingOneOf(flags.AllFlagName, cli.NamesArgumentName))
+	}
+
+	return errs
+}
+
+func (opts *DeliverableDeleteOptions) Exec(ctx context.Context, c *cli.Config) error {
+	deliverable := &cartov1alpha1.Deliverable{}
+
+	if opts.All {
+		if !opts.Yes {
+			okToDeleteAll := false
+			err := cli.NewConfirmSurvey(c, "Really delete all deliverables in the namespace %q?", opts.Namespace).Resolve(&okToDeleteAll)
+			if err != nil || !okToDeleteAll {
+				c.Infof("Skipping deliverables in namespace %q\n", opts.Namespace)
+				return nil
+			}
+		}
+		err := c.DeleteAllOf(ctx, deliverable, client.InNamespace(opts.Namespace))
+		if err != nil {
+			return err
+		}
+		c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Deleted deliverables in namespace %q\n", opts.Namespace))
+		return nil
+	}
+
+	for _, name := range opts.Names {
+		if err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: name}, deliverable); err != nil {
+			if apierrs.IsNotFound(err) {
+				c.Infof("Deliverable %q does not exist\n", name)
+				continue
+			}
+			return err
+		}
+		if !opts.Yes {
+			okToDelete := false
+			err := cli.NewConfirmSurvey(c, "Really delete the deliverable %q?", name).Resolve(&okToDelete)
+			if err != nil || !okToDelete {
+				c.Infof("Skipping deliverable %q\n", name)
+				continue
+			}
+		}
+		if err := c.Delete(ctx, deliverable); err != nil {
+			return err
+		}
+		c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Deleted deliverable %q\n", name))
+		if opts.Wait {
+			c.Infof("Waiting for deliverable %q to be deleted...\n", name)
+			workers := []wait.Worker{
+				func(ctx context.Context) error {
+					return wait.UntilDelete(ctx, c.Client, deliverable)
+				},
+			}
+			if err := wait.Race(ctx, opts.WaitTimeout, workers); err != nil {
+				if err == context.DeadlineExceeded {
+					c.Printf("%s timeout after %s waiting for %q to be deleted\n", printer.Serrorf("Error:"), opts.WaitTimeout, name)
+					c.Infof("To view status run: tanzu apps deliverable get %s %s %s\n", name, flags.NamespaceFlagName, opts.Namespace)
+					return cli.SilenceError(err)
+				}
+				c.Eprintf("%s %s\n", printer.Serrorf("Error:"), err)
+				return cli.SilenceError(err)
+			}
+			c.Infof("Deliverable %q was deleted\n", name)
+		}
+	}
+
+	return nil
+}
+
+func NewDeliverableDeleteCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &DeliverableDeleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete deliverable(s)",
+		Long: strings.TrimSpace(`
+Delete one or more deliverables by name or all deliverables within a namespace.
+
+Deleting a deliverable removes the resources stamped by its delivery. If the
+deliverable was created by a workload on the same cluster, it is created again
+unless the workload is deleted too.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s deliverable delete my-deliverable", c.Name),
+			fmt.Sprintf("%s deliverable delete %s", c.Name, flags.AllFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestDeliverableNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NamesArg(&opts.Names),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().BoolVar(&opts.All, cli.StripDash(flags.AllFlagName), false, "delete all deliverables within the namespace")
+	cmd.Flags().BoolVar(&opts.Wait, cli.StripDash(flags.WaitFlagName), false, "waits for deliverable to be deleted")
+	cmd.Flags().DurationVar(&opts.WaitTimeout, cli.StripDash(flags.WaitTimeoutFlagName), 1*time.Minute, "timeout for deliverable to be deleted when waiting")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.WaitTimeoutFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
+	cmd.Flags().BoolVarP(&opts.Yes, cli.StripDash(flags.YesFlagName), "y", false, "accept all prompts")
+
+	return cmd
+}
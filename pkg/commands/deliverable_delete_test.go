@@ -0,0 +1,331 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"context"
+	"fmt"
+	runtm "runtime"
+	"strings"
+	"testing"
+	"time"
+
+	diemetav1 "dies.dev/apis/meta/v1"
+	rtesting "github.com/vmware-labs/reconciler-runtime/testing"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/wait"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestDeliverableDeleteOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.DeliverableDeleteOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingOneOf(flags.AllFlagName, cli.NamesArgumentName),
+			),
+		},
+		{
+			Name: "name",
+			Validatable: &commands.DeliverableDeleteOptions{
+				Namespace: "default",
+				Names:     []string{"my-deliverable"},
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "all",
+			Validatable: &commands.DeliverableDeleteOptions{
+				Namespace: "default",
+				All:       true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "name + all",
+			Validatable: &commands.DeliverableDeleteOptions{
+				Namespace: "default",
+				Names:     []string{"my-deliverable"},
+				All:       true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.AllFlagName, cli.NamesArgumentName),
+		},
+		{
+			Name: "wait",
+			Validatable: &commands.DeliverableDeleteOptions{
+				Namespace:   "default",
+				Names:       []string{"my-deliverable"},
+				Wait:        true,
+				WaitTimeout: time.Minute,
+			},
+			ShouldValidate: true,
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestDeliverableDeleteCommand(t *testing.T) {
+	deliverableName := "test-deliverable"
+	deliverableOtherName := "test-other-deliverable"
+	defaultNamespace := "default"
+
+	scheme := runtime.NewScheme()
+	cartov1alpha1.AddToScheme(scheme)
+
+	previousBackOffTime := wait.BackOffTime
+	defer func() {
+		wait.BackOffTime = previousBackOffTime
+	}()
+	wait.BackOffTime = 10 * time.Millisecond
+
+	failingReactionFunc := func(verb, resource string) clitesting.ReactionFunc {
+		apiCount, callCountToFail := 0, 1
+		return func(action clitesting.Action) (bool, runtime.Object, error) {
+			if verb == action.GetVerb() && resource == action.GetResource().Resource {
+				if apiCount == callCountToFail {
+					return true, nil, fmt.Errorf("client error")
+				}
+				apiCount++
+			}
+			return true, nil, nil
+		}
+	}
+
+	parent := diecartov1alpha1.DeliverableBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(deliverableName)
+			d.Namespace(defaultNamespace)
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "invalid args",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name: "delete all deliverables",
+			Args: []string{flags.AllFlagName, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectDeleteCollections: []rtesting.DeleteCollectionRef{{
+				Group:     "carto.run",
+				Kind:      "Deliverable",
+				Namespace: defaultNamespace,
+				Fields:    fields.Everything(),
+				Labels:    labels.NewSelector(),
+			}},
+			ExpectOutput: `
+👍 Deleted deliverables in namespace "default"
+`,
+		},
+		{
+			Name:  "delete all deliverables, prompt denied",
+			Args:  []string{flags.AllFlagName},
+			Stdin: []byte("no"),
+			GivenObjects: []client.Object{
+				parent,
+			},
+			Verify: func(t *testing.T, output string, err error) {
+				if !strings.Contains(output, `Really delete all deliverables in the namespace "default"?`) {
+					t.Errorf("expected output to contain delete prompt")
+				}
+				if !strings.Contains(output, `Skipping deliverables in namespace "default"`) {
+					t.Errorf("expected output to contain skip confirmation")
+				}
+			},
+		},
+		{
+			Name: "delete all deliverables error",
+			Args: []string{flags.AllFlagName, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("delete-collection", "Deliverable"),
+			},
+			ExpectDeleteCollections: []rtesting.DeleteCollectionRef{{
+				Group:     "carto.run",
+				Kind:      "Deliverable",
+				Namespace: defaultNamespace,
+				Fields:    fields.Everything(),
+				Labels:    labels.NewSelector(),
+			}},
+			ShouldError: true,
+		},
+		{
+			Name: "delete deliverable",
+			Args: []string{deliverableName, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectDeletes: []rtesting.DeleteRef{{
+				Group:     "carto.run",
+				Kind:      "Deliverable",
+				Namespace: defaultNamespace,
+				Name:      deliverableName,
+			}},
+			ExpectOutput: `
+👍 Deleted deliverable "test-deliverable"
+`,
+		},
+		{
+			Name:  "delete deliverable, prompt denied",
+			Args:  []string{deliverableName},
+			Stdin: []byte("no"),
+			GivenObjects: []client.Object{
+				parent,
+			},
+			Verify: func(t *testing.T, output string, err error) {
+				if !strings.Contains(output, `Really delete the deliverable "test-deliverable"?`) {
+					t.Errorf("expected output to contain delete prompt")
+				}
+				if !strings.Contains(output, `Skipping deliverable "test-deliverable"`) {
+					t.Errorf("expected output to contain skip confirmation")
+				}
+			},
+		},
+		{
+			Name: "delete deliverables",
+			Args: []string{deliverableName, deliverableOtherName, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent,
+				diecartov1alpha1.DeliverableBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(deliverableOtherName)
+						d.Namespace(defaultNamespace)
+					}),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{{
+				Group:     "carto.run",
+				Kind:      "Deliverable",
+				Namespace: defaultNamespace,
+				Name:      deliverableName,
+			}, {
+				Group:     "carto.run",
+				Kind:      "Deliverable",
+				Namespace: defaultNamespace,
+				Name:      deliverableOtherName,
+			}},
+			ExpectOutput: `
+👍 Deleted deliverable "test-deliverable"
+👍 Deleted deliverable "test-other-deliverable"
+`,
+		},
+		{
+			Name: "deliverable does not exist",
+			Args: []string{deliverableName, flags.YesFlagName},
+			ExpectOutput: `
+Deliverable "test-deliverable" does not exist
+`,
+		},
+		{
+			Name: "delete error",
+			Args: []string{deliverableName, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("delete", "Deliverable"),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{{
+				Group:     "carto.run",
+				Kind:      "Deliverable",
+				Namespace: defaultNamespace,
+				Name:      deliverableName,
+			}},
+			ShouldError: true,
+		},
+		{
+			Name: "delete deliverable confirmed after wait",
+			Args: []string{deliverableName, flags.YesFlagName, flags.WaitFlagName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectDeletes: []rtesting.DeleteRef{{
+				Group:     "carto.run",
+				Kind:      "Deliverable",
+				Namespace: defaultNamespace,
+				Name:      deliverableName,
+			}},
+			ExpectOutput: `
+👍 Deleted deliverable "test-deliverable"
+Waiting for deliverable "test-deliverable" to be deleted...
+Deliverable "test-deliverable" was deleted
+`,
+		},
+		{
+			Name: "delete deliverable failed with wait",
+			Args: []string{deliverableName, flags.YesFlagName, flags.WaitFlagName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				failingReactionFunc("get", "Deliverable"),
+			},
+			ShouldError: true,
+			ExpectDeletes: []rtesting.DeleteRef{{
+				Group:     "carto.run",
+				Kind:      "Deliverable",
+				Namespace: defaultNamespace,
+				Name:      deliverableName,
+			}},
+		},
+		{
+			Name: "delete deliverable failed with wait timeout error",
+			Skip: runtm.GOOS == "windows",
+			Args: []string{deliverableName, flags.YesFlagName, flags.WaitFlagName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				ctx, cancel := context.WithTimeout(ctx, 1*time.Nanosecond)
+				defer cancel()
+				return ctx, nil
+			},
+			ShouldError: true,
+			ExpectOutput: `
+👍 Deleted deliverable "test-deliverable"
+Waiting for deliverable "test-deliverable" to be deleted...
+Error: timeout after 1m0s waiting for "test-deliverable" to be deleted
+To view status run: tanzu apps deliverable get test-deliverable --namespace default
+`,
+			ExpectDeletes: []rtesting.DeleteRef{{
+				Group:     "carto.run",
+				Kind:      "Deliverable",
+				Namespace: defaultNamespace,
+				Name:      deliverableName,
+			}},
+		},
+	}
+
+	table.Run(t, scheme, commands.NewDeliverableDeleteCommand)
+}
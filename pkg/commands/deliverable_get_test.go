@@ -0,0 +1,339 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestDeliverableGetOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "invalid empty",
+			Validatable: &commands.DeliverableGetOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.DeliverableGetOptions{
+				Namespace: "default",
+				Name:      "my-deliverable",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "valid output format",
+			Validatable: &commands.DeliverableGetOptions{
+				Namespace: "default",
+				Name:      "my-deliverable",
+				Output:    "json",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output format",
+			Validatable: &commands.DeliverableGetOptions{
+				Namespace: "default",
+				Name:      "my-deliverable",
+				Output:    "myFormat",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("myFormat", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestDeliverableGetCommand(t *testing.T) {
+	deliverableName := "my-deliverable"
+	defaultNamespace := "default"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	parent := diecartov1alpha1.DeliverableBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(deliverableName)
+			d.Namespace(defaultNamespace)
+		})
+	delivered := parent.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.AddLabel(apis.WorkloadTypeLabelName, "web")
+		}).
+		SpecDie(func(d *diecartov1alpha1.DeliverableSpecDie) {
+			d.Source(&cartov1alpha1.Source{
+				Git: &cartov1alpha1.GitSource{
+					URL: "https://github.com/example/my-deliverable-config",
+					Ref: cartov1alpha1.GitRef{Branch: "main"},
+				},
+			})
+		}).
+		StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+			d.DeliveryRef(cartov1alpha1.ObjectReference{
+				Kind: "ClusterDelivery",
+				Name: "delivery-basic",
+			})
+			d.Resources(
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("source-provider").
+					StampedRef(&cartov1alpha1.StampedRef{
+						Resource: "gitrepositories.source.toolkit.fluxcd.io",
+						ObjectReference: &corev1.ObjectReference{
+							Kind:      "GitRepository",
+							Namespace: defaultNamespace,
+							Name:      deliverableName + "-delivery",
+						}}).
+					ConditionsResourceHealthyReadyTrueDie().
+					DieRelease(),
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("deployer").
+					StampedRef(&cartov1alpha1.StampedRef{
+						ObjectReference: &corev1.ObjectReference{
+							Kind:      "App",
+							Namespace: defaultNamespace,
+							Name:      deliverableName,
+						}}).
+					ConditionsResourceHealthyReadyTrueDie().
+					DieRelease(),
+			)
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "invalid args",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "no delivery info",
+			Args:         []string{deliverableName},
+			GivenObjects: []client.Object{parent},
+			ExpectOutput: `
+📡 Overview
+   name:        my-deliverable
+   type:        <empty>
+   namespace:   default
+
+Delivery reference not found.
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+To see logs: "tanzu apps deliverable tail my-deliverable --timestamp --since 1h"
+
+`,
+		},
+		{
+			Name: "show source, delivery and resources",
+			Args: []string{deliverableName},
+			GivenObjects: []client.Object{
+				delivered.
+					StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.CreateConditionReadyTrue("", ""),
+							diecartov1alpha1.CreateConditionHealthyTrue("", ""),
+						)
+					}),
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-deliverable
+   type:        web
+   namespace:   default
+
+💾 Source
+   type:     git
+   url:      https://github.com/example/my-deliverable-config
+   branch:   main
+
+🚚 Delivery
+   name:   delivery-basic
+
+   NAME              READY   HEALTHY   UPDATED     RESOURCE
+   source-provider   True    True      <unknown>   gitrepositories.source.toolkit.fluxcd.io/my-deliverable-delivery
+   deployer          True    True      <unknown>   App/my-deliverable
+
+💬 Messages
+   No messages found.
+
+To see logs: "tanzu apps deliverable tail my-deliverable --timestamp --since 1h"
+
+`,
+		},
+		{
+			Name: "show issues",
+			Args: []string{deliverableName, flags.NamespaceFlagName, defaultNamespace},
+			GivenObjects: []client.Object{
+				delivered.
+					StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.CreateConditionReadyUnknown(
+								"OopsieDoodle",
+								"a hopefully informative message about what went wrong"),
+							diecartov1alpha1.CreateConditionHealthyUnknown(
+								"AnotherOopsieDoodle",
+								"a hopefully informative message about what is not healthy"),
+						)
+					}),
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-deliverable
+   type:        web
+   namespace:   default
+
+💾 Source
+   type:     git
+   url:      https://github.com/example/my-deliverable-config
+   branch:   main
+
+🚚 Delivery
+   name:   delivery-basic
+
+   NAME              READY   HEALTHY   UPDATED     RESOURCE
+   source-provider   True    True      <unknown>   gitrepositories.source.toolkit.fluxcd.io/my-deliverable-delivery
+   deployer          True    True      <unknown>   App/my-deliverable
+
+💬 Messages
+   Deliverable [OopsieDoodle]:          a hopefully informative message about what went wrong
+   Deliverable [AnotherOopsieDoodle]:   a hopefully informative message about what is not healthy
+
+To see logs: "tanzu apps deliverable tail my-deliverable --timestamp --since 1h"
+
+`,
+		},
+		{
+			Name: "show image source in other namespace",
+			Args: []string{deliverableName, flags.NamespaceFlagName, "other-namespace"},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Namespace("other-namespace")
+					}).
+					SpecDie(func(d *diecartov1alpha1.DeliverableSpecDie) {
+						d.Source(&cartov1alpha1.Source{
+							Image: "registry.example/my-deliverable-bundle:latest",
+						})
+					}),
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-deliverable
+   type:        <empty>
+   namespace:   other-namespace
+
+💾 Source
+   type:    source image
+   image:   registry.example/my-deliverable-bundle:latest
+
+Delivery reference not found.
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+To see logs: "tanzu apps deliverable tail my-deliverable --namespace other-namespace --timestamp --since 1h"
+
+`,
+		},
+		{
+			Name: "output yaml",
+			Args: []string{deliverableName, flags.OutputFlagName, "yaml"},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.CreationTimestamp(metav1.Date(2021, time.September, 10, 15, 00, 00, 00, time.UTC))
+					}),
+			},
+			ExpectOutput: `
+---
+apiVersion: carto.run/v1alpha1
+kind: Deliverable
+metadata:
+  creationTimestamp: "2021-09-10T15:00:00Z"
+  name: my-deliverable
+  namespace: default
+  resourceVersion: "999"
+spec: {}
+status:
+  deliveryRef: {}
+`,
+		},
+		{
+			Name: "not found",
+			Args: []string{deliverableName},
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Deliverable "default/my-deliverable" not found
+`,
+		},
+		{
+			Name: "namespace not found",
+			Args: []string{deliverableName, flags.NamespaceFlagName, "foo"},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Namespace", clitesting.InduceFailureOpts{
+					Error: apierrors.NewNotFound(corev1.Resource("Namespace"), "foo"),
+				}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: namespace "foo" not found, it may not exist or user does not have permissions to read it.
+`,
+		},
+		{
+			Name: "get error",
+			Args: []string{deliverableName},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Deliverable"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewDeliverableGetCommand)
+}
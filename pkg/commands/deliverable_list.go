@@ -0,0 +1,228 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+type DeliverableListOptions struct {
+	Namespace     string
+	AllNamespaces bool
+	Output        string
+}
+
+var (
+	_ validation.Validatable = (*DeliverableListOptions)(nil)
+	_ cli.Executable         = (*DeliverableListOptions)(nil)
+)
+
+func (opts *DeliverableListOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" && !opts.AllNamespaces {
+		errs = errs.Also(validation.ErrMissingOneOf(flags.NamespaceFlagName, flags.AllNamespacesFlagName))
+	}
+	if opts.Namespace != "" && opts.AllNamespaces {
+		errs = errs.Also(validation.ErrMultipleOneOf(flags.NamespaceFlagName, flags.AllNamespacesFlagName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validateListOutput(opts.Output, false))
+	}
+
+	return errs
+}
+
+func (opts *DeliverableListOptions) Exec(ctx context.Context, c *cli.Config) error {
+	deliverables := &cartov1alpha1.DeliverableList{}
+	if err := c.List(ctx, deliverables, client.InNamespace(opts.Namespace)); err != nil {
+		return err
+	}
+
+	format, arg, _ := strings.Cut(opts.Output, "=")
+	switch format {
+	case printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml, printer.OutputFormatJSONPath:
+		var list []printer.Object
+		for i := range deliverables.Items {
+			list = append(list, &deliverables.Items[i])
+		}
+		var export string
+		var err error
+		if format == printer.OutputFormatJSONPath {
+			export, err = printer.OutputJSONPath(list, arg, c.Scheme)
+		} else {
+			export, err = printer.OutputResources(list, printer.OutputFormat(opts.Output), c.Scheme)
+			export = export + "\n"
+		}
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output deliverable:"), err)
+			return cli.SilenceError(err)
+		}
+
+		c.Printf("%s", export)
+		return nil
+	}
+
+	if len(deliverables.Items) == 0 {
+		nsGet := &corev1.Namespace{}
+		if getErr := c.Get(ctx, types.NamespacedName{Name: opts.Namespace}, nsGet); getErr != nil && apierrors.IsNotFound(getErr) {
+			c.Eprintf("%s %s\n", printer.Serrorf("Error:"), fmt.Sprintf("namespace %q not found, it may not exist or user does not have permissions to read it.", opts.Namespace))
+			return cli.SilenceError(getErr)
+		}
+		c.Infof("No deliverables found.\n")
+		return nil
+	}
+
+	var tablePrinter *table.HumanReadablePrinter
+	if format == printer.OutputFormatCustomColumns {
+		// the format is checked when the options are validated
+		columns, _ := printer.ParseCustomColumns(arg)
+		tablePrinter = table.NewTablePrinter(table.PrintOptions{
+			WithNamespace: opts.AllNamespaces,
+		}).With(func(h table.PrintHandler) {
+			h.TableHandler(customColumnDefinitions(columns), printDeliverableCustomColumns(columns))
+		})
+	} else {
+		tablePrinter = table.NewTablePrinter(table.PrintOptions{
+			WithNamespace: opts.AllNamespaces,
+			Wide:          format == printer.OutputFormatWide,
+		}).With(func(h table.PrintHandler) {
+			columns := opts.printColumns()
+			h.TableHandler(columns, opts.printList)
+			h.TableHandler(columns, opts.print)
+		})
+	}
+
+	deliverables = deliverables.DeepCopy()
+	printer.SortByNamespaceAndName(deliverables.Items)
+
+	return tablePrinter.PrintObj(deliverables, c.Stdout)
+}
+
+func NewDeliverableListCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &DeliverableListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Table listing of deliverables",
+		Long: strings.TrimSpace(`
+List deliverables in a namespace or across all namespaces.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s deliverable list", c.Name),
+			fmt.Sprintf("%s deliverable list %s", c.Name, flags.AllNamespacesFlagName),
+			fmt.Sprintf("%s deliverable list %s wide", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE: cli.ValidateE(ctx, opts),
+		RunE:    cli.ExecE(ctx, c, opts),
+	}
+
+	cli.AllNamespacesFlag(ctx, cmd, c, &opts.Namespace, &opts.AllNamespaces)
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Deliverables formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"wide\", \"custom-columns=<header>:<json-path-expr>,...\", \"jsonpath=<template>\"")
+
+	return cmd
+}
+
+func (opts *DeliverableListOptions) printList(deliverables *cartov1alpha1.DeliverableList, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(deliverables.Items))
+	for i := range deliverables.Items {
+		r, err := opts.print(&deliverables.Items[i], printOpts)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func (opts *DeliverableListOptions) print(deliverable *cartov1alpha1.Deliverable, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+	now := time.Now()
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: deliverable},
+	}
+	readyCond := printer.FindCondition(deliverable.Status.Conditions, cartov1alpha1.ConditionReady)
+	row.Cells = append(row.Cells,
+		deliverable.Name,
+		printer.EmptyString(deliverableSource(deliverable)),
+		printer.EmptyString(deliverable.Status.DeliveryRef.Name),
+		printer.ConditionStatus(readyCond),
+		printer.TimestampSince(deliverable.CreationTimestamp, now),
+	)
+	if printOpts.Wide {
+		reason := ""
+		if readyCond != nil {
+			reason = readyCond.Reason
+		}
+		row.Cells = append(row.Cells, printer.EmptyString(reason))
+	}
+	return []metav1beta1.TableRow{row}, nil
+}
+
+func (opts *DeliverableListOptions) printColumns() []metav1beta1.TableColumnDefinition {
+	return []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string"},
+		{Name: "Source", Type: "string"},
+		{Name: "Delivery", Type: "string"},
+		{Name: "Ready", Type: "string"},
+		{Name: "Age", Type: "string"},
+		{Name: "Reason", Type: "string", Priority: 1},
+	}
+}
+
+func printDeliverableCustomColumns(columns []printer.CustomColumn) func(*cartov1alpha1.DeliverableList, table.PrintOptions) ([]metav1beta1.TableRow, error) {
+	return func(deliverables *cartov1alpha1.DeliverableList, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(deliverables.Items))
+		for i := range deliverables.Items {
+			row, err := customColumnsRow(&deliverables.Items[i], columns)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, row)
+		}
+		return rows, nil
+	}
+}
+
+// deliverableSource describes where the deliverable is delivered from, the git repository and ref
+// or the image.
+func deliverableSource(deliverable *cartov1alpha1.Deliverable) string {
+	if source := deliverable.Spec.Source; source != nil {
+		return workloadSource(&cartov1alpha1.Workload{
+			Spec: cartov1alpha1.WorkloadSpec{Source: source},
+		})
+	}
+	return ""
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestDeliverableListOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.DeliverableListOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingOneOf(flags.NamespaceFlagName, flags.AllNamespacesFlagName),
+			),
+		},
+		{
+			Name: "namespace",
+			Validatable: &commands.DeliverableListOptions{
+				Namespace: "default",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "all namespaces",
+			Validatable: &commands.DeliverableListOptions{
+				AllNamespaces: true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid namespace + all",
+			Validatable: &commands.DeliverableListOptions{
+				Namespace:     "default",
+				AllNamespaces: true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.NamespaceFlagName, flags.AllNamespacesFlagName),
+		},
+		{
+			Name: "valid output format",
+			Validatable: &commands.DeliverableListOptions{
+				Namespace: "default",
+				Output:    "wide",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output format",
+			Validatable: &commands.DeliverableListOptions{
+				Namespace: "default",
+				Output:    "myFormat",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("myFormat", flags.OutputFlagName, []string{"json", "yaml", "yml", "wide"}),
+		},
+		{
+			Name: "custom-columns output format",
+			Validatable: &commands.DeliverableListOptions{
+				Namespace: "default",
+				Output:    "custom-columns=NAME:.metadata.name",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid custom-columns output format",
+			Validatable: &commands.DeliverableListOptions{
+				Namespace: "default",
+				Output:    "custom-columns=NAME",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("custom-columns=NAME", flags.OutputFlagName),
+		},
+		{
+			Name: "invalid jsonpath output format",
+			Validatable: &commands.DeliverableListOptions{
+				Namespace: "default",
+				Output:    "jsonpath=",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("jsonpath=", flags.OutputFlagName),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestDeliverableListCommand(t *testing.T) {
+	deliverableName := "test-deliverable"
+	defaultNamespace := "default"
+	otherNamespace := "other-namespace"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	//timezone differences and daylight savings are causing issues
+	//and when comparing against time.now, the output is not always 2Y as expected.
+	//setting a default location(with no day light savings) to mitiage this problem.
+	loc, _ := time.LoadLocation("America/Puerto_Rico")
+	objTimeStamp := metav1.NewTime(time.Now().In(loc).AddDate(-2, 0, 0))
+
+	parent := diecartov1alpha1.DeliverableBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(deliverableName)
+			d.Namespace(defaultNamespace)
+			d.CreationTimestamp(objTimeStamp)
+		})
+	delivered := parent.
+		SpecDie(func(d *diecartov1alpha1.DeliverableSpecDie) {
+			d.Source(&cartov1alpha1.Source{
+				Git: &cartov1alpha1.GitSource{
+					URL: "https://github.com/example/test-deliverable-config",
+					Ref: cartov1alpha1.GitRef{Branch: "main"},
+				},
+			})
+		}).
+		StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+			d.DeliveryRef(cartov1alpha1.ObjectReference{Kind: "ClusterDelivery", Name: "delivery-basic"})
+			d.ConditionsDie(
+				diecartov1alpha1.ConditionReadyBlank.Status(metav1.ConditionFalse).Reason("TemplateStampFailure"),
+			)
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name: "empty",
+			Args: []string{},
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+			},
+			ExpectOutput: `
+No deliverables found.
+`,
+		},
+		{
+			Name: "lists an item",
+			Args: []string{},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+NAME               SOURCE    DELIVERY   READY       AGE
+test-deliverable   <empty>   <empty>    <unknown>   2y
+`,
+		},
+		{
+			Name: "lists an item, with detail",
+			Args: []string{},
+			GivenObjects: []client.Object{
+				delivered,
+			},
+			ExpectOutput: `
+NAME               SOURCE                                                    DELIVERY         READY                  AGE
+test-deliverable   https://github.com/example/test-deliverable-config@main   delivery-basic   TemplateStampFailure   2y
+`,
+		},
+		{
+			Name: "lists items in wide format",
+			Args: []string{flags.OutputFlagName, "wide"},
+			GivenObjects: []client.Object{
+				delivered,
+			},
+			ExpectOutput: `
+NAME               SOURCE                                                    DELIVERY         READY                  AGE   REASON
+test-deliverable   https://github.com/example/test-deliverable-config@main   delivery-basic   TemplateStampFailure   2y    TemplateStampFailure
+`,
+		},
+		{
+			Name: "lists items in custom-columns format",
+			Args: []string{flags.OutputFlagName, "custom-columns=NAME:.metadata.name,DELIVERY:.status.deliveryRef.name"},
+			GivenObjects: []client.Object{
+				delivered,
+			},
+			ExpectOutput: `
+NAME               DELIVERY
+test-deliverable   delivery-basic
+`,
+		},
+		{
+			Name: "lists items in jsonpath format",
+			Args: []string{flags.OutputFlagName, "jsonpath={.items[*].metadata.name}"},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: "test-deliverable",
+		},
+		{
+			Name: "lists all items in yaml format",
+			Args: []string{flags.OutputFlagName, "yaml"},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.CreationTimestamp(metav1.Date(2021, time.September, 10, 15, 00, 00, 00, time.UTC))
+					}),
+			},
+			ExpectOutput: `
+---
+- apiVersion: carto.run/v1alpha1
+  kind: Deliverable
+  metadata:
+    creationTimestamp: "2021-09-10T15:00:00Z"
+    name: test-deliverable
+    namespace: default
+    resourceVersion: "999"
+  spec: {}
+  status:
+    deliveryRef: {}
+`,
+		},
+		{
+			Name: "namespace not found",
+			Args: []string{flags.NamespaceFlagName, "foo"},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Namespace", clitesting.InduceFailureOpts{
+					Error: apierrors.NewNotFound(corev1.Resource("Namespace"), "foo"),
+				}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: namespace "foo" not found, it may not exist or user does not have permissions to read it.
+`,
+		},
+		{
+			Name: "all namespace",
+			Args: []string{flags.AllNamespacesFlagName},
+			GivenObjects: []client.Object{
+				parent,
+				diecartov1alpha1.DeliverableBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("test-other-deliverable")
+						d.Namespace(otherNamespace)
+						d.CreationTimestamp(objTimeStamp)
+					}),
+			},
+			ExpectOutput: `
+NAMESPACE         NAME                     SOURCE    DELIVERY   READY       AGE
+default           test-deliverable         <empty>   <empty>    <unknown>   2y
+other-namespace   test-other-deliverable   <empty>   <empty>    <unknown>   2y
+`,
+		},
+		{
+			Name: "all namespace in custom-columns format",
+			Args: []string{flags.AllNamespacesFlagName, flags.OutputFlagName, "custom-columns=NAME:.metadata.name"},
+			GivenObjects: []client.Object{
+				parent,
+				diecartov1alpha1.DeliverableBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("test-other-deliverable")
+						d.Namespace(otherNamespace)
+						d.CreationTimestamp(objTimeStamp)
+					}),
+			},
+			ExpectOutput: `
+NAMESPACE         NAME
+default           test-deliverable
+other-namespace   test-other-deliverable
+`,
+		},
+		{
+			Name: "list error",
+			Args: []string{},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "DeliverableList"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewDeliverableListCommand)
+}
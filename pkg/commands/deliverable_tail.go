@@ -0,0 +1,131 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/logs"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+type DeliverableTailOptions struct {
+	Namespace string
+	Name      string
+
+	Since      time.Duration
+	Timestamps bool
+}
+
+var (
+	_ validation.Validatable = (*DeliverableTailOptions)(nil)
+	_ cli.Executable         = (*DeliverableTailOptions)(nil)
+)
+
+func (opts *DeliverableTailOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	} else {
+		errs = errs.Also(validation.K8sName(opts.Name, cli.NameArgumentName))
+	}
+
+	if opts.Since < 0 {
+		errs = errs.Also(validation.ErrInvalidValue(opts.Since, flags.SinceFlagName))
+	}
+
+	return errs
+}
+
+func (opts *DeliverableTailOptions) Exec(ctx context.Context, c *cli.Config) error {
+	deliverable := &cartov1alpha1.Deliverable{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: opts.Name}, deliverable)
+	if err != nil {
+		if !apierrs.IsNotFound(err) {
+			return err
+		}
+		c.Errorf("Deliverable %q not found\n", fmt.Sprintf("%s/%s", opts.Namespace, opts.Name))
+		return cli.SilenceError(err)
+	}
+
+	// the pods of the delivered workload keep the workload name label, which the deliverable
+	// carries when it was stamped by a supply chain
+	workloadName := deliverable.Labels[cartov1alpha1.WorkloadLabelName]
+	if workloadName == "" {
+		workloadName = deliverable.Name
+	}
+	// a deliverable name can be longer than a label value
+	selector, err := labels.ValidatedSelectorFromSet(labels.Set{cartov1alpha1.WorkloadLabelName: workloadName})
+	if err != nil {
+		c.Errorf("Unable to select the pods of deliverable %q: %s\n", fmt.Sprintf("%s/%s", opts.Namespace, opts.Name), err)
+		return cli.SilenceError(err)
+	}
+	return logs.Tail(ctx, c, opts.Namespace, selector, logs.TailOptions{
+		Containers: []string{},
+		Since:      opts.Since,
+		Follow:     true,
+		Timestamps: opts.Timestamps,
+	})
+}
+
+func NewDeliverableTailCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &DeliverableTailOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "tail",
+		Short: "Watch deliverable related logs",
+		Long: strings.TrimSpace(`
+Stream logs for the pods of a deliverable until canceled. To cancel, press
+Ctl-c in the shell or stop the process. As new pods are started, the logs
+are displayed. To show historical logs use ` + flags.SinceFlagName + `.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s deliverable tail my-deliverable", c.Name),
+			fmt.Sprintf("%s deliverable tail my-deliverable %s 1h", c.Name, flags.SinceFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestDeliverableNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().BoolVarP(&opts.Timestamps, cli.StripDash(flags.TimestampFlagName), "t", false, "print timestamp for each log line")
+	cmd.Flags().DurationVar(&opts.Since, cli.StripDash(flags.SinceFlagName), time.Minute, "time `duration` to start reading logs from")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.SinceFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
+	return cmd
+}
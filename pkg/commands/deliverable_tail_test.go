@@ -0,0 +1,172 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	diemetav1 "dies.dev/apis/meta/v1"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/logs"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestDeliverableTailOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "invalid empty",
+			Validatable: &commands.DeliverableTailOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.DeliverableTailOptions{
+				Namespace: "default",
+				Name:      "my-deliverable",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid name",
+			Validatable: &commands.DeliverableTailOptions{
+				Namespace: "default",
+				Name:      "my-",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("my-", cli.NameArgumentName),
+		},
+		{
+			Name: "invalid since",
+			Validatable: &commands.DeliverableTailOptions{
+				Namespace: "default",
+				Name:      "my-deliverable",
+				Since:     -1,
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue(-1*time.Nanosecond, flags.SinceFlagName),
+		},
+	}
+	table.Run(t)
+}
+
+func TestDeliverableTailCommand(t *testing.T) {
+	deliverableName := "test-deliverable"
+	workloadName := "test-workload"
+	defaultNamespace := "default"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+
+	parent := diecartov1alpha1.DeliverableBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(deliverableName)
+			d.Namespace(defaultNamespace)
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "empty",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:        "failed to get deliverable",
+			Args:        []string{flags.NamespaceFlagName, defaultNamespace, deliverableName},
+			ShouldError: true,
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Deliverable"),
+			},
+		},
+		{
+			Name: "missing deliverable",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, deliverableName},
+			ExpectOutput: `
+Deliverable "default/test-deliverable" not found
+`,
+			ShouldError: true,
+		},
+		{
+			Name: "show logs for deliverable",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, flags.SinceFlagName, "1h", deliverableName},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				tailer := &logs.FakeTailer{}
+				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, deliverableName))
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Hour, Follow: true}).Return(nil).Once()
+				ctx = logs.StashTailer(ctx, tailer)
+				// simulate a user exit after 10ms
+				ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+				_ = cancel
+				return ctx, nil
+			},
+			CleanUp: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) error {
+				tailer := logs.RetrieveTailer(ctx).(*logs.FakeTailer)
+				tailer.AssertExpectations(t)
+				return nil
+			},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+...tail output...
+`,
+		},
+		{
+			Name: "show logs for the workload of the deliverable",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, flags.TimestampFlagName, deliverableName},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				tailer := &logs.FakeTailer{}
+				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Minute, Follow: true, Timestamps: true}).Return(nil).Once()
+				ctx = logs.StashTailer(ctx, tailer)
+				// simulate a user exit after 10ms
+				ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+				_ = cancel
+				return ctx, nil
+			},
+			CleanUp: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) error {
+				tailer := logs.RetrieveTailer(ctx).(*logs.FakeTailer)
+				tailer.AssertExpectations(t)
+				return nil
+			},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(cartov1alpha1.WorkloadLabelName, workloadName)
+					}),
+			},
+			ExpectOutput: `
+...tail output...
+`,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewDeliverableTailCommand)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+)
+
+func TestDeliverableCommand(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name: "empty",
+			Args: []string{},
+			Verify: func(t *testing.T, output string, err error) {
+				if !strings.Contains(output, "Commands:") {
+					t.Errorf("output expected to contain help with nested commands to call")
+				}
+			},
+		},
+	}
+
+	table.Run(t, scheme, commands.NewDeliverableCommand)
+}
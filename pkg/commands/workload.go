@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -37,6 +38,8 @@ import (
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
@@ -75,9 +78,13 @@ Workload configuration includes:
 	cmd.AddCommand(NewWorkloadListCommand(ctx, c))
 	cmd.AddCommand(NewWorkloadGetCommand(ctx, c))
 	cmd.AddCommand(NewWorkloadTailCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadExplainCommand(ctx, c))
 	cmd.AddCommand(NewWorkloadCreateCommand(ctx, c))
 	cmd.AddCommand(NewWorkloadUpdateCommand(ctx, c))
 	cmd.AddCommand(NewWorkloadApplyCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadDiffCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadHistoryCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadRollbackCommand(ctx, c))
 	cmd.AddCommand(NewWorkloadDeleteCommand(ctx, c))
 
 	return cmd
@@ -97,6 +104,7 @@ type WorkloadOptions struct {
 	LiveUpdate  bool
 
 	FilePath        string
+	Recursive       bool
 	GitRepo         string
 	GitCommit       string
 	GitBranch       string
@@ -135,8 +143,17 @@ type WorkloadOptions struct {
 	TailTimestamps bool
 	DryRun         bool
 	Yes            bool
+	StrictParams   bool
+
+	UpdateAttempts int
+	HistoryLimit   int
 }
 
+// FileWorkloadLayer layers the workload from the input file on top of a copy of the latest workload
+// on the cluster. It is used to rebuild the workload to submit when an update conflicts with a
+// concurrent change, before the flags are applied again.
+type FileWorkloadLayer func(workload *cartov1alpha1.Workload) *cartov1alpha1.Workload
+
 var _ validation.Validatable = (*WorkloadUpdateOptions)(nil)
 
 func (opts *WorkloadOptions) Validate(ctx context.Context) validation.FieldErrors {
@@ -175,6 +192,18 @@ func (opts *WorkloadOptions) Validate(ctx context.Context) validation.FieldError
 		errs = errs.Also(validation.CompareQuantity(opts.LimitMemory, opts.RequestMemory, flags.RequestMemoryFlagName))
 	}
 
+	if opts.Recursive && opts.FilePath == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.FilePathFlagName))
+	}
+
+	if cmd := cli.CommandFromContext(ctx); cmd != nil && cmd.Flags().Changed(cli.StripDash(flags.UpdateAttemptsFlagName)) && opts.UpdateAttempts < 1 {
+		errs = errs.Also(validation.ErrInvalidValue(opts.UpdateAttempts, flags.UpdateAttemptsFlagName))
+	}
+
+	if opts.HistoryLimit < 0 {
+		errs = errs.Also(validation.ErrInvalidValue(opts.HistoryLimit, flags.HistoryLimitFlagName))
+	}
+
 	if opts.RegistryPassword != "" || opts.RegistryUsername != "" || opts.RegistryToken != "" || len(opts.CACertPaths) != 0 {
 		if opts.SourceImage == "" {
 			errs = errs.Also(validation.ErrMissingField(flags.SourceImageFlagName))
@@ -515,7 +544,12 @@ func validateNamespace(ctx context.Context, c *cli.Config, name string) error {
 	return nil
 }
 
-func (opts *WorkloadOptions) Update(ctx context.Context, c *cli.Config, currentWorkload *cartov1alpha1.Workload, workload *cartov1alpha1.Workload) (bool, error) {
+// Update submits the changes to the workload after confirming them. When the update conflicts with
+// a concurrent change to the workload, the latest workload is fetched, the input file is layered
+// with layerFile (if not nil) and the flags are applied again, up to the number of attempts set by
+// --update-attempts. The user is only asked to confirm again when the changes differ from the ones
+// already confirmed.
+func (opts *WorkloadOptions) Update(ctx context.Context, c *cli.Config, currentWorkload *cartov1alpha1.Workload, workload *cartov1alpha1.Workload, layerFile FileWorkloadLayer) (bool, error) {
 	okToUpdate := false
 
 	if msgs := workload.DeprecationWarnings(); len(msgs) != 0 {
@@ -524,7 +558,7 @@ func (opts *WorkloadOptions) Update(ctx context.Context, c *cli.Config, currentW
 		}
 	}
 
-	difference, noChange, err := printer.ResourceDiff(currentWorkload, workload, c.Scheme)
+	difference, noChange, err := workloadDiff(currentWorkload, workload, c)
 	if err != nil {
 		return okToUpdate, err
 	}
@@ -553,23 +587,83 @@ func (opts *WorkloadOptions) Update(ctx context.Context, c *cli.Config, currentW
 				return okToUpdate, nil
 			}
 		}
-	} else {
-		okToUpdate = opts.Yes
 	}
 
-	if err := c.Update(ctx, workload); err != nil {
-		okToUpdate = false
+	okToUpdate, err = opts.updateWithRetries(ctx, c, currentWorkload, workload, difference, layerFile)
+	if err != nil {
 		if apierrs.IsConflict(err) {
 			c.Printf("%s conflict updating workload, the object was modified by another user; please run the update command again\n", printer.Serrorf("Error:"))
-			return okToUpdate, cli.SilenceError(err)
+			return false, cli.SilenceError(err)
 		}
-		return okToUpdate, err
+		return false, err
+	}
+	if okToUpdate {
+		c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Updated workload %q\n", workload.Name))
 	}
-
-	c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Updated workload %q\n", workload.Name))
 	return okToUpdate, nil
 }
 
+// updateWithRetries updates the workload, retrying up to the number of attempts set by
+// --update-attempts when the update conflicts with a concurrent change. The difference is the one
+// already confirmed for the workload. It returns false when the changes are dropped on a retry,
+// and the conflict error once every attempt conflicted.
+func (opts *WorkloadOptions) updateWithRetries(ctx context.Context, c *cli.Config, currentWorkload, workload *cartov1alpha1.Workload, difference string, layerFile FileWorkloadLayer) (bool, error) {
+	attempts := opts.UpdateAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	for attempt := 1; ; attempt++ {
+		if err := opts.recordHistory(ctx, c, currentWorkload, workload); err != nil {
+			return false, err
+		}
+		err := c.Update(ctx, workload)
+		if err == nil {
+			return true, nil
+		}
+		if !apierrs.IsConflict(err) || attempt >= attempts {
+			return false, err
+		}
+
+		c.Infof("Workload %q was modified by another user, retrying update (attempt %d of %d)\n", workload.Name, attempt+1, attempts)
+		latestWorkload := &cartov1alpha1.Workload{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: workload.Namespace, Name: workload.Name}, latestWorkload); err != nil {
+			return false, err
+		}
+		retryWorkload := latestWorkload.DeepCopy()
+		if layerFile != nil {
+			retryWorkload = layerFile(retryWorkload)
+		}
+		opts.ApplyOptionsToWorkload(ctx, retryWorkload)
+		if opts.LocalPath != "" {
+			// keep the source published before the first attempt
+			retryWorkload.Spec.Source = workload.Spec.Source.DeepCopy()
+		}
+		retryDifference, noChange, err := workloadDiff(latestWorkload, retryWorkload, c)
+		if err != nil {
+			return false, err
+		}
+		if noChange {
+			c.Infof("Workload is unchanged, skipping update\n")
+			return false, nil
+		}
+		if retryDifference != difference {
+			c.Emoji(cli.Magnifying, "Update workload:\n")
+			c.Printf("%s", retryDifference)
+			if !opts.Yes {
+				okToUpdate := false
+				err := cli.NewConfirmSurvey(c, "Really update the workload %q?", workload.Name).Resolve(&okToUpdate)
+				if err != nil || !okToUpdate {
+					c.Infof("Skipping workload %q\n", workload.Name)
+					return false, nil
+				}
+			}
+			difference = retryDifference
+		}
+		currentWorkload = latestWorkload
+		retryWorkload.DeepCopyInto(workload)
+	}
+}
+
 func (opts *WorkloadOptions) Create(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) (bool, error) {
 	okToCreate := false
 
@@ -579,7 +673,7 @@ func (opts *WorkloadOptions) Create(ctx context.Context, c *cli.Config, workload
 		}
 	}
 
-	diff, _, err := printer.ResourceDiff(nil, workload, c.Scheme)
+	diff, _, err := workloadDiff(nil, workload, c)
 	if err != nil {
 		return okToCreate, err
 	}
@@ -607,6 +701,9 @@ func (opts *WorkloadOptions) Create(ctx context.Context, c *cli.Config, workload
 		okToCreate = opts.Yes
 	}
 
+	if err := opts.recordHistory(ctx, c, nil, workload); err != nil {
+		return okToCreate, err
+	}
 	if err := c.Create(ctx, workload); err != nil {
 		return okToCreate, err
 	}
@@ -615,29 +712,267 @@ func (opts *WorkloadOptions) Create(ctx context.Context, c *cli.Config, workload
 	return okToCreate, nil
 }
 
-func (opts *WorkloadOptions) LoadInputWorkload(input io.Reader, workload *cartov1alpha1.Workload) error {
-	var in io.Reader
+// workloadChange is a workload ready to be submitted along with its current state on the
+// cluster. The current workload is nil when the workload does not exist yet.
+type workloadChange struct {
+	current  *cartov1alpha1.Workload
+	workload *cartov1alpha1.Workload
+	notices  []string
+	// applyConfig is submitted as a server-side apply patch with the patch options instead of
+	// creating or updating the workload when set.
+	applyConfig  *cartov1alpha1.Workload
+	patchOptions []client.PatchOption
+	// layerFile rebuilds the workload when an update conflicts with a concurrent change
+	layerFile FileWorkloadLayer
+	// difference is the diff confirmed for the workload
+	difference string
+}
 
-	isURL, err := isUrl(opts.FilePath)
-	if err != nil {
-		return fmt.Errorf("unable to check if filepath %q is a valid url: %w", opts.FilePath, err)
+// validateMultipleWorkloads rejects flags that target a single workload when the input file
+// describes more than one.
+func (opts *WorkloadOptions) validateMultipleWorkloads() validation.FieldErrors {
+	errs := validation.FieldErrors{}
+	detail := "not supported with files containing multiple workload descriptions"
+
+	if opts.Name != "" {
+		errs = errs.Also(validation.ErrDisallowedFields(cli.NameArgumentName, detail))
+	}
+	if opts.LocalPath != "" {
+		errs = errs.Also(validation.ErrDisallowedFields(flags.LocalPathFlagName, detail))
+	}
+	if opts.Wait {
+		errs = errs.Also(validation.ErrDisallowedFields(flags.WaitFlagName, detail))
+	}
+	if opts.Tail {
+		errs = errs.Also(validation.ErrDisallowedFields(flags.TailFlagName, detail))
+	}
+	if opts.TailTimestamps {
+		errs = errs.Also(validation.ErrDisallowedFields(flags.TailTimestampFlagName, detail))
 	}
 
-	if isURL {
-		in, err = opts.getUrlFileContent()
+	return errs
+}
+
+// submitWorkloads shows a combined diff for every change and, after a single confirmation,
+// creates or updates each workload. Errors are collected per workload and reported once every
+// workload has been processed, together with the failures passed in by the caller.
+func (opts *WorkloadOptions) submitWorkloads(ctx context.Context, c *cli.Config, changes []workloadChange, failures []error) error {
+	pending := []workloadChange{}
+	creates := 0
+	for _, change := range changes {
+		workload := change.workload
+		for _, msg := range workload.DeprecationWarnings() {
+			c.Emoji(cli.Exclamation, cliprinter.Sinfof("WARNING: %s\n", msg))
+		}
+
+		difference, noChange, err := workloadDiff(change.current, workload, c)
 		if err != nil {
-			return fmt.Errorf("unable to read from url %q: %w", opts.FilePath, err)
+			failures = append(failures, fmt.Errorf("workload %q: %w", workload.Name, err))
+			continue
 		}
-	} else if opts.FilePath == "-" {
-		in = input
-	} else {
-		f, err := os.Open(opts.FilePath)
+		if noChange {
+			c.Infof("Workload %q is unchanged, skipping update\n", workload.Name)
+			continue
+		}
+		if change.current == nil {
+			creates++
+			c.Emoji(cli.Magnifying, "Create workload %q:\n", workload.Name)
+		} else {
+			c.Emoji(cli.Magnifying, "Update workload %q:\n", workload.Name)
+		}
+		c.Printf("%s", difference)
+		for _, msg := range change.notices {
+			c.Emoji(cli.Exclamation, cliprinter.Sinfof("NOTICE: %s\n", msg))
+		}
+		change.difference = difference
+		pending = append(pending, change)
+	}
+
+	okToSubmit := len(pending) != 0
+	if okToSubmit && !opts.Yes {
+		okToSubmit = false
+		if opts.FilePath == "-" {
+			c.Errorf("Skipping workloads, cannot confirm intent. Run command with %s flag to confirm intent when providing input from stdin\n", flags.YesFlagName)
+		} else {
+			prompt := cli.NewConfirmSurvey(c, "Really apply changes to %d workloads?", len(pending))
+			if creates == len(pending) {
+				prompt = cli.NewConfirmSurvey(c, "Do you want to create these %d workloads?", len(pending))
+			}
+			if err := prompt.Resolve(&okToSubmit); err != nil || !okToSubmit {
+				c.Infof("Skipping workloads\n")
+				okToSubmit = false
+			}
+		}
+	}
+
+	if okToSubmit {
+		for _, change := range pending {
+			workload := change.workload
+			if change.applyConfig != nil {
+				if err := opts.recordApplyHistory(ctx, c, change.current, workload, change.applyConfig); err != nil {
+					failures = append(failures, fmt.Errorf("workload %q: %w", workload.Name, err))
+					continue
+				}
+				if err := c.Patch(ctx, change.applyConfig, client.Apply, change.patchOptions...); err != nil {
+					if apierrs.IsConflict(err) {
+						err = fmt.Errorf("conflict applying workload, some fields are managed by another field manager; run the command again with %s to take ownership of them: %w", flags.ForceConflictsFlagName, err)
+					}
+					failures = append(failures, fmt.Errorf("workload %q: %w", workload.Name, err))
+					continue
+				}
+				if change.current == nil {
+					c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Created workload %q\n", workload.Name))
+				} else {
+					c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Updated workload %q\n", workload.Name))
+				}
+			} else if change.current == nil {
+				if err := opts.recordHistory(ctx, c, nil, workload); err != nil {
+					failures = append(failures, fmt.Errorf("workload %q: %w", workload.Name, err))
+					continue
+				}
+				if err := c.Create(ctx, workload); err != nil {
+					failures = append(failures, fmt.Errorf("workload %q: %w", workload.Name, err))
+					continue
+				}
+				c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Created workload %q\n", workload.Name))
+			} else {
+				okToUpdate, err := opts.updateWithRetries(ctx, c, change.current, workload, change.difference, change.layerFile)
+				if err != nil {
+					if apierrs.IsConflict(err) {
+						err = fmt.Errorf("conflict updating workload, the object was modified by another user; please run the command again: %w", err)
+					}
+					failures = append(failures, fmt.Errorf("workload %q: %w", workload.Name, err))
+					continue
+				}
+				if okToUpdate {
+					c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Updated workload %q\n", workload.Name))
+				}
+			}
+		}
+	}
+
+	return reportWorkloadFailures(c, failures)
+}
+
+// printSupplyChainMatch predicts the cluster supply chain that will take the workload, by
+// evaluating the selectors of every supply chain the same way Cartographer does once the workload
+// is submitted.
+func printSupplyChainMatch(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) {
+	supplyChains := &cartov1alpha1.ClusterSupplyChainList{}
+	if err := c.List(ctx, supplyChains); err != nil {
+		c.Emoji(cli.Exclamation, cliprinter.Sinfof("WARNING: unable to list cluster supply chains to match workload %q: %s\n", workload.Name, err))
+		return
+	}
+	printer.SortByNamespaceAndName(supplyChains.Items)
+	matches, err := cartov1alpha1.MatchingClusterSupplyChains(supplyChains.Items, workload)
+	if err != nil {
+		c.Emoji(cli.Exclamation, cliprinter.Sinfof("WARNING: unable to match workload %q: %s\n", workload.Name, err))
+		return
+	}
+
+	switch len(matches) {
+	case 0:
+		c.Emoji(cli.Exclamation, cliprinter.Sinfof("WARNING: workload %q does not match any cluster supply chain\n", workload.Name))
+	case 1:
+		c.Infof("Workload %q matches cluster supply chain %q\n", workload.Name, matches[0].Name)
+	default:
+		names := make([]string, 0, len(matches))
+		for _, match := range matches {
+			names = append(names, fmt.Sprintf("%q", match.Name))
+		}
+		c.Emoji(cli.Exclamation, cliprinter.Sinfof("WARNING: workload %q matches multiple cluster supply chains (%s), it will not be taken by any of them\n", workload.Name, strings.Join(names, ", ")))
+	}
+}
+
+// checkStrictParams resolves the value each template of the cluster supply chain that will take the
+// workload gets for its params, and prints them. Workload params that no template declares are
+// reported as an error, as they are most likely a typo.
+func checkStrictParams(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) error {
+	supplyChains := &cartov1alpha1.ClusterSupplyChainList{}
+	if err := c.List(ctx, supplyChains); err != nil {
+		return fmt.Errorf("unable to list cluster supply chains to validate params of workload %q: %w", workload.Name, err)
+	}
+	printer.SortByNamespaceAndName(supplyChains.Items)
+	matches, err := cartov1alpha1.MatchingClusterSupplyChains(supplyChains.Items, workload)
+	if err != nil {
+		return err
+	}
+	if len(matches) != 1 {
+		return fmt.Errorf("unable to validate params of workload %q, it must match exactly one cluster supply chain but matches %d", workload.Name, len(matches))
+	}
+	supplyChain := &matches[0]
+
+	templatesParams := map[string]cartov1alpha1.TemplateParams{}
+	for i := range supplyChain.Spec.Resources {
+		resource := &supplyChain.Spec.Resources[i]
+		template := resource.TemplateRef.Template()
+		if err := c.Get(ctx, client.ObjectKey{Name: resource.TemplateRef.Name}, template); err != nil {
+			if apierrs.IsNotFound(err) {
+				return fmt.Errorf("template %s %q of resource %q not found", resource.TemplateRef.Kind, resource.TemplateRef.Name, resource.Name)
+			}
+			return err
+		}
+		params, err := cartov1alpha1.GetTemplateParams(template)
 		if err != nil {
-			return fmt.Errorf("unable to open file %q: %w", opts.FilePath, err)
+			return err
 		}
-		in = f
-		defer f.Close()
+		templatesParams[resource.Name] = params
+	}
+
+	params, unused, overridden := supplyChain.ResolveParams(workload, templatesParams)
+	c.Infof("Params of workload %q for cluster supply chain %q\n", workload.Name, supplyChain.Name)
+	if len(params) == 0 {
+		c.Infof("No params declared by the templates\n")
+	} else if err := printer.WorkloadParamsPrinter(c.Stdout, workload, params); err != nil {
+		return err
+	}
+	for _, name := range overridden {
+		c.Emoji(cli.Exclamation, cliprinter.Sinfof("WARNING: param %q of workload %q is ignored, cluster supply chain %q sets its value\n", name, workload.Name, supplyChain.Name))
+	}
+	if len(unused) != 0 {
+		names := make([]string, 0, len(unused))
+		for _, name := range unused {
+			names = append(names, fmt.Sprintf("%q", name))
+		}
+		return fmt.Errorf("params %s of workload %q are not declared by any template of cluster supply chain %q", strings.Join(names, ", "), workload.Name, supplyChain.Name)
+	}
+	return nil
+}
+
+// reportWorkloadFailures prints every error collected while processing multiple workloads.
+func reportWorkloadFailures(c *cli.Config, failures []error) error {
+	if len(failures) == 0 {
+		return nil
+	}
+	c.Printf("\n")
+	for _, err := range failures {
+		c.Eprintf("%s %s\n", printer.Serrorf("Error:"), err)
+	}
+	return cli.SilenceError(utilerrors.NewAggregate(failures))
+}
+
+// resolveWorkloadNamespace returns the namespace a workload from the input file belongs to, the
+// namespace flag takes precedence when it is explicitly set.
+func (opts *WorkloadOptions) resolveWorkloadNamespace(ctx context.Context, fileWorkload *cartov1alpha1.Workload) string {
+	if fileWorkload.Namespace == "" || cli.CommandFromContext(ctx).Flags().Changed(cli.StripDash(flags.NamespaceFlagName)) {
+		return opts.Namespace
+	}
+	return fileWorkload.Namespace
+}
+
+func workloadDocumentName(workload *cartov1alpha1.Workload, index int) string {
+	if workload.Name == "" {
+		return fmt.Sprintf("document %d", index+1)
 	}
+	return fmt.Sprintf("workload %q", workload.Name)
+}
+
+func (opts *WorkloadOptions) LoadInputWorkload(input io.Reader, workload *cartov1alpha1.Workload) error {
+	in, closer, err := opts.openInput(input)
+	if err != nil {
+		return err
+	}
+	defer closer()
 
 	if err := workload.Load(in); err != nil {
 		return fmt.Errorf("unable to load file %q: %w", opts.FilePath, err)
@@ -645,6 +980,138 @@ func (opts *WorkloadOptions) LoadInputWorkload(input io.Reader, workload *cartov
 	return nil
 }
 
+// LoadInputWorkloads reads every workload description from the input file, directory, glob
+// pattern, stdin or url. Other flags are layered on top of each returned workload by the caller.
+func (opts *WorkloadOptions) LoadInputWorkloads(input io.Reader) ([]*cartov1alpha1.Workload, error) {
+	paths, err := opts.expandFilePath()
+	if err != nil {
+		return nil, err
+	}
+	if paths == nil {
+		in, closer, err := opts.openInput(input)
+		if err != nil {
+			return nil, err
+		}
+		defer closer()
+
+		workloads, err := cartov1alpha1.LoadWorkloads(in)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load file %q: %w", opts.FilePath, err)
+		}
+		return workloads, nil
+	}
+
+	workloads := []*cartov1alpha1.Workload{}
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open file %q: %w", path, err)
+		}
+		fileWorkloads, err := cartov1alpha1.LoadWorkloads(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to load file %q: %w", path, err)
+		}
+		workloads = append(workloads, fileWorkloads...)
+	}
+	return workloads, nil
+}
+
+// expandFilePath resolves a directory or glob pattern in the file path flag into the list of
+// workload files it refers to. A nil list is returned when the flag points to a single input.
+func (opts *WorkloadOptions) expandFilePath() ([]string, error) {
+	if opts.FilePath == "-" {
+		return nil, nil
+	}
+	if isURL, err := isUrl(opts.FilePath); err != nil || isURL {
+		return nil, nil
+	}
+
+	matches := []string{opts.FilePath}
+	isPattern := strings.ContainsAny(opts.FilePath, "*?[")
+	if isPattern {
+		var err error
+		if matches, err = filepath.Glob(opts.FilePath); err != nil {
+			return nil, fmt.Errorf("invalid file pattern %q: %w", opts.FilePath, err)
+		}
+	} else if info, err := os.Stat(opts.FilePath); err != nil || !info.IsDir() {
+		return nil, nil
+	}
+
+	paths := []string{}
+	for _, match := range matches {
+		info, err := os.Stat(match)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open file %q: %w", match, err)
+		}
+		if !info.IsDir() {
+			paths = append(paths, match)
+			continue
+		}
+		dirPaths, err := opts.workloadFilesInDir(match)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, dirPaths...)
+	}
+	if len(paths) == 0 {
+		if isPattern {
+			return nil, fmt.Errorf("no files match pattern %q", opts.FilePath)
+		}
+		return nil, fmt.Errorf("no workload files found in directory %q", opts.FilePath)
+	}
+	return paths, nil
+}
+
+// workloadFilesInDir lists the yaml and json files in a directory, descending into
+// subdirectories when the recursive flag is set.
+func (opts *WorkloadOptions) workloadFilesInDir(dir string) ([]string, error) {
+	paths := []string{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != dir && !opts.Recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read directory %q: %w", dir, err)
+	}
+	return paths, nil
+}
+
+func (opts *WorkloadOptions) openInput(input io.Reader) (io.Reader, func(), error) {
+	isURL, err := isUrl(opts.FilePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to check if filepath %q is a valid url: %w", opts.FilePath, err)
+	}
+
+	if isURL {
+		in, err := opts.getUrlFileContent()
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to read from url %q: %w", opts.FilePath, err)
+		}
+		return in, func() {}, nil
+	}
+	if opts.FilePath == "-" {
+		return input, func() {}, nil
+	}
+	f, err := os.Open(opts.FilePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to open file %q: %w", opts.FilePath, err)
+	}
+	return f, func() { f.Close() }, nil
+}
+
 func (opts *WorkloadOptions) getUrlFileContent() (io.Reader, error) {
 	resp, err := http.Get(opts.FilePath)
 	if err != nil {
@@ -675,7 +1142,7 @@ func isUrl(str string) (bool, error) {
 
 func (opts *WorkloadOptions) DefineFlags(ctx context.Context, c *cli.Config, cmd *cobra.Command) {
 	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
-	cmd.Flags().StringVarP(&opts.FilePath, cli.StripDash(flags.FilePathFlagName), "f", "", "`file path` containing the description of a single workload, other flags are layered on top of this resource. Use value \"-\" to read from stdin")
+	cmd.Flags().StringVarP(&opts.FilePath, cli.StripDash(flags.FilePathFlagName), "f", "", "`file path`, directory or glob pattern containing the description of one or more workloads, other flags are layered on top of each resource. Use value \"-\" to read from stdin")
 	cmd.Flags().StringVarP(&opts.App, cli.StripDash(flags.AppFlagName), "a", "", "application `name` the workload is a part of")
 	cmd.Flags().StringVarP(&opts.Type, cli.StripDash(flags.TypeFlagName), "t", "", "distinguish workload `type`")
 	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.TypeFlagName), func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -720,6 +1187,7 @@ func (opts *WorkloadOptions) DefineFlags(ctx context.Context, c *cli.Config, cmd
 	cmd.MarkFlagFilename(cli.StripDash(flags.FilePathFlagName), ".yaml", ".yml")
 	cmd.Flags().BoolVar(&opts.DryRun, cli.StripDash(flags.DryRunFlagName), false, "print kubernetes resources to stdout rather than apply them to the cluster, messages normally on stdout will be sent to stderr")
 	cmd.Flags().BoolVarP(&opts.Yes, cli.StripDash(flags.YesFlagName), "y", false, "accept all prompts")
+	cmd.Flags().BoolVar(&opts.StrictParams, cli.StripDash(flags.StrictParamsFlagName), false, "validate the workload params against the params declared by the templates of the matching cluster supply chain, failing on params no template consumes")
 }
 
 func (opts *WorkloadOptions) DefineEnvVars(ctx context.Context, c *cli.Config, cmd *cobra.Command) {
@@ -24,6 +24,7 @@ import (
 
 	"github.com/spf13/cobra"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +32,7 @@ import (
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/logs"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/wait"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch"
@@ -42,6 +44,8 @@ import (
 type WorkloadApplyOptions struct {
 	WorkloadOptions
 	UpdateStrategy string
+	ServerSide     bool
+	ForceConflicts bool
 }
 
 var (
@@ -53,6 +57,10 @@ var (
 const (
 	mergeUpdateStrategy   = "merge"
 	replaceUpdateStrategy = "replace"
+
+	// WorkloadFieldManager is the field manager used to own the fields of server-side applied
+	// workloads.
+	WorkloadFieldManager = "tanzu-apps-cli"
 )
 
 func (opts *WorkloadApplyOptions) Validate(ctx context.Context) validation.FieldErrors {
@@ -64,6 +72,13 @@ func (opts *WorkloadApplyOptions) Validate(ctx context.Context) validation.Field
 			errs = errs.Also(validation.ErrMissingField(flags.FilePathFlagName))
 		}
 		errs = errs.Also(validation.Enum(opts.UpdateStrategy, flags.UpdateStrategyFlagName, []string{mergeUpdateStrategy, replaceUpdateStrategy}))
+		if opts.ServerSide {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.ServerSideFlagName, flags.UpdateStrategyFlagName))
+		}
+	}
+
+	if opts.ForceConflicts && !opts.ServerSide {
+		errs = errs.Also(validation.ErrMissingField(flags.ServerSideFlagName))
 	}
 
 	return errs
@@ -77,10 +92,17 @@ func (opts *WorkloadApplyOptions) Exec(ctx context.Context, c *cli.Config) error
 
 	fileWorkload := &cartov1alpha1.Workload{}
 	if opts.FilePath != "" {
-		c.Emoji(cli.Exclamation, fmt.Sprintf("WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use %q to control strategy explicitly).\n\n", flags.UpdateStrategyFlagName))
-		if err := opts.WorkloadOptions.LoadInputWorkload(c.Stdin, fileWorkload); err != nil {
+		if !opts.ServerSide {
+			c.Emoji(cli.Exclamation, fmt.Sprintf("WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use %q to control strategy explicitly).\n\n", flags.UpdateStrategyFlagName))
+		}
+		fileWorkloads, err := opts.WorkloadOptions.LoadInputWorkloads(c.Stdin)
+		if err != nil {
 			return err
 		}
+		if len(fileWorkloads) > 1 {
+			return opts.applyWorkloads(ctx, c, fileWorkloads)
+		}
+		fileWorkload = fileWorkloads[0]
 
 		if opts.Name == "" {
 			opts.Name = fileWorkload.Name
@@ -118,28 +140,10 @@ func (opts *WorkloadApplyOptions) Exec(ctx context.Context, c *cli.Config) error
 		}
 	}
 
-	if opts.UpdateStrategy == mergeUpdateStrategy {
-		if opts.FilePath != "" {
-			var serviceAccountCopy string
-			// avoid passing a nil pointer to MergeServiceAccountName func
-			if fileWorkload.Spec.ServiceAccountName != nil {
-				serviceAccountCopy = *fileWorkload.Spec.ServiceAccountName
-			}
-
-			workload.Spec.MergeServiceAccountName(serviceAccountCopy)
-		}
-		workload.Merge(fileWorkload)
-	}
-
-	if opts.UpdateStrategy == replaceUpdateStrategy {
-		// assign all the file workload fields to the workload in the cluster
-		workload = fileWorkload
-
-		// if there is a workload in the cluster with all metadata populated
-		// re assign the system populated fields so we won't find an error because of some missing fields
-		workload.ReplaceMetadata(currentWorkload)
-	}
-
+	applyConfig := opts.workloadApplyConfiguration(ctx, fileWorkload, opts.Namespace, opts.Name)
+	// the replace strategy reuses the file workload, keep a copy to layer it again on update retries
+	initialFileWorkload := fileWorkload.DeepCopy()
+	workload = opts.layerFileWorkload(workload, currentWorkload, fileWorkload)
 	workload.Name = opts.Name
 	workload.Namespace = opts.Namespace
 	ctx = opts.ApplyOptionsToWorkload(ctx, workload)
@@ -158,7 +162,19 @@ func (opts *WorkloadApplyOptions) Exec(ctx context.Context, c *cli.Config) error
 		return err
 	}
 
+	if opts.StrictParams {
+		if err := checkStrictParams(ctx, c, workload); err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Error:"), err)
+			return cli.SilenceError(err)
+		}
+	}
+
 	if opts.DryRun {
+		printSupplyChainMatch(ctx, c, workload)
+		if applyConfig != nil {
+			cli.DryRunResource(ctx, applyConfig, applyConfig.GetGroupVersionKind())
+			return nil
+		}
 		cli.DryRunResource(ctx, workload, workload.GetGroupVersionKind())
 		return nil
 	}
@@ -170,14 +186,27 @@ func (opts *WorkloadApplyOptions) Exec(ctx context.Context, c *cli.Config) error
 		return nil
 	}
 
-	// If there is no workload, create a new one
-	if currentWorkload == nil {
+	if applyConfig != nil {
+		if opts.LocalPath != "" {
+			// keep the digest of the published source
+			applyConfig.Spec.Source = workload.Spec.Source.DeepCopy()
+		}
+		okToUpdate, updateError = opts.Apply(ctx, c, currentWorkload, workload, applyConfig)
+		if updateError != nil {
+			return updateError
+		}
+	} else if currentWorkload == nil {
 		okToCreate, createError = opts.Create(ctx, c, workload)
 		if createError != nil {
 			return createError
 		}
 	} else {
-		okToUpdate, updateError = opts.Update(ctx, c, currentWorkload, workload)
+		okToUpdate, updateError = opts.Update(ctx, c, currentWorkload, workload, func(workload *cartov1alpha1.Workload) *cartov1alpha1.Workload {
+			workload = opts.layerFileWorkload(workload, workload.DeepCopy(), initialFileWorkload.DeepCopy())
+			workload.Name = opts.Name
+			workload.Namespace = opts.Namespace
+			return workload
+		})
 		if updateError != nil {
 			return updateError
 		}
@@ -209,8 +238,12 @@ func (opts *WorkloadApplyOptions) Exec(ctx context.Context, c *cli.Config) error
 				if err != nil {
 					panic(err)
 				}
-				containers := []string{}
-				return logs.Tail(ctx, c, opts.Namespace, selector, containers, time.Minute, opts.TailTimestamps)
+				return logs.Tail(ctx, c, opts.Namespace, selector, logs.TailOptions{
+					Containers: []string{},
+					Since:      time.Minute,
+					Follow:     true,
+					Timestamps: opts.TailTimestamps,
+				})
 			})
 		}
 
@@ -227,6 +260,214 @@ func (opts *WorkloadApplyOptions) Exec(ctx context.Context, c *cli.Config) error
 	return nil
 }
 
+// layerFileWorkload combines the workload from the input file with the workload on the cluster
+// following the requested update strategy.
+func (opts *WorkloadApplyOptions) layerFileWorkload(workload, currentWorkload, fileWorkload *cartov1alpha1.Workload) *cartov1alpha1.Workload {
+	if opts.UpdateStrategy == mergeUpdateStrategy {
+		if opts.FilePath != "" {
+			var serviceAccountCopy string
+			// avoid passing a nil pointer to MergeServiceAccountName func
+			if fileWorkload.Spec.ServiceAccountName != nil {
+				serviceAccountCopy = *fileWorkload.Spec.ServiceAccountName
+			}
+
+			workload.Spec.MergeServiceAccountName(serviceAccountCopy)
+		}
+		workload.Merge(fileWorkload)
+	}
+
+	if opts.UpdateStrategy == replaceUpdateStrategy {
+		// assign all the file workload fields to the workload in the cluster
+		workload = fileWorkload
+
+		// if there is a workload in the cluster with all metadata populated
+		// re assign the system populated fields so we won't find an error because of some missing fields
+		workload.ReplaceMetadata(currentWorkload)
+	}
+
+	return workload
+}
+
+// applyWorkloads creates or updates every workload described in a multi document input file.
+func (opts *WorkloadApplyOptions) applyWorkloads(ctx context.Context, c *cli.Config, fileWorkloads []*cartov1alpha1.Workload) error {
+	if err := opts.validateMultipleWorkloads().ToAggregate(); err != nil {
+		return err
+	}
+
+	changes := []workloadChange{}
+	failures := []error{}
+	for i, fileWorkload := range fileWorkloads {
+		change, err := opts.prepareWorkload(ctx, c, fileWorkload)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("%s: %w", workloadDocumentName(fileWorkload, i), err))
+			continue
+		}
+		if opts.StrictParams {
+			if err := checkStrictParams(ctx, c, change.workload); err != nil {
+				failures = append(failures, fmt.Errorf("%s: %w", workloadDocumentName(fileWorkload, i), err))
+				continue
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	if opts.DryRun {
+		for _, change := range changes {
+			printSupplyChainMatch(ctx, c, change.workload)
+			if change.applyConfig != nil {
+				cli.DryRunResource(ctx, change.applyConfig, change.applyConfig.GetGroupVersionKind())
+				continue
+			}
+			cli.DryRunResource(ctx, change.workload, change.workload.GetGroupVersionKind())
+		}
+		return reportWorkloadFailures(c, failures)
+	}
+
+	return opts.submitWorkloads(ctx, c, changes, failures)
+}
+
+func (opts *WorkloadApplyOptions) prepareWorkload(ctx context.Context, c *cli.Config, fileWorkload *cartov1alpha1.Workload) (workloadChange, error) {
+	namespace := opts.resolveWorkloadNamespace(ctx, fileWorkload)
+	errs := validation.FieldErrors{}
+	if fileWorkload.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+	if namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+	if err := errs.ToAggregate(); err != nil {
+		return workloadChange{}, err
+	}
+
+	workload := &cartov1alpha1.Workload{}
+	var currentWorkload *cartov1alpha1.Workload
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: fileWorkload.Name}, workload); err == nil {
+		currentWorkload = workload.DeepCopy()
+	} else if !apierrs.IsNotFound(err) {
+		return workloadChange{}, err
+	} else if _, nsErr := loadNamespace(ctx, c, namespace); nsErr != nil {
+		return workloadChange{}, fmt.Errorf("namespace %q not found, it may not exist or user does not have permissions to read it", namespace)
+	}
+
+	applyConfig := opts.workloadApplyConfiguration(ctx, fileWorkload, namespace, fileWorkload.Name)
+	// the replace strategy reuses the file workload, keep a copy to layer it again on update retries
+	initialFileWorkload := fileWorkload.DeepCopy()
+	workload = opts.layerFileWorkload(workload, currentWorkload, fileWorkload)
+	workload.Name = fileWorkload.Name
+	workload.Namespace = namespace
+	ctx = opts.ApplyOptionsToWorkload(ctx, workload)
+	if err := workload.Validate().ToAggregate(); err != nil {
+		return workloadChange{}, err
+	}
+
+	layerFile := func(workload *cartov1alpha1.Workload) *cartov1alpha1.Workload {
+		workload = opts.layerFileWorkload(workload, workload.DeepCopy(), initialFileWorkload.DeepCopy())
+		workload.Name = initialFileWorkload.Name
+		workload.Namespace = namespace
+		return workload
+	}
+	return workloadChange{current: currentWorkload, workload: workload, applyConfig: applyConfig, patchOptions: opts.applyPatchOptions(), layerFile: layerFile, notices: workload.GetNotices(ctx)}, nil
+}
+
+// workloadApplyConfiguration returns the workload to submit as a server-side apply patch, or nil
+// when server-side apply is not requested. Unlike the workload shown in the diff, it only holds
+// the fields set by the file and the flags, so fields owned by other field managers are kept.
+func (opts *WorkloadApplyOptions) workloadApplyConfiguration(ctx context.Context, fileWorkload *cartov1alpha1.Workload, namespace, name string) *cartov1alpha1.Workload {
+	if !opts.ServerSide {
+		return nil
+	}
+	fileWorkload = fileWorkload.DeepCopy()
+	applyConfig := &cartov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			Labels:      fileWorkload.Labels,
+			Annotations: fileWorkload.Annotations,
+		},
+		Spec: fileWorkload.Spec,
+	}
+	opts.ApplyOptionsToWorkload(ctx, applyConfig)
+	applyConfig.APIVersion, applyConfig.Kind = applyConfig.GetGroupVersionKind().ToAPIVersionAndKind()
+	return applyConfig
+}
+
+func (opts *WorkloadApplyOptions) applyPatchOptions() []client.PatchOption {
+	patchOptions := []client.PatchOption{client.FieldOwner(WorkloadFieldManager)}
+	if opts.ForceConflicts {
+		patchOptions = append(patchOptions, client.ForceOwnership)
+	}
+	return patchOptions
+}
+
+// Apply submits the apply configuration as a server-side apply patch. The diff shown is between
+// the current workload and the workload expected once the configuration is applied.
+func (opts *WorkloadApplyOptions) Apply(ctx context.Context, c *cli.Config, currentWorkload, workload, applyConfig *cartov1alpha1.Workload) (bool, error) {
+	okToApply := false
+
+	if msgs := workload.DeprecationWarnings(); len(msgs) != 0 {
+		for _, msg := range msgs {
+			c.Emoji(cli.Exclamation, cliprinter.Sinfof("WARNING: %s\n", msg))
+		}
+	}
+
+	difference, noChange, err := workloadDiff(currentWorkload, workload, c)
+	if err != nil {
+		return okToApply, err
+	}
+
+	if noChange && currentWorkload != nil {
+		c.Infof("Workload is unchanged, skipping update\n")
+		return okToApply, nil
+	}
+	question := fmt.Sprintf("Really update the workload %q?", workload.Name)
+	if currentWorkload == nil {
+		question = "Do you want to create this workload?"
+		c.Emoji(cli.Magnifying, "Create workload:\n")
+	} else {
+		c.Emoji(cli.Magnifying, "Update workload:\n")
+	}
+	c.Printf("%s", difference)
+
+	if noticeMsgs := workload.GetNotices(ctx); len(noticeMsgs) != 0 {
+		for _, msg := range noticeMsgs {
+			c.Emoji(cli.Exclamation, cliprinter.Sinfof("NOTICE: %s\n", msg))
+		}
+	}
+
+	if !opts.Yes {
+		if opts.FilePath == "-" {
+			c.Errorf("Skipping workload, cannot confirm intent. Run command with %s flag to confirm intent when providing input from stdin\n", flags.YesFlagName)
+			return okToApply, nil
+		}
+		if err := cli.NewConfirmSurvey(c, question).Resolve(&okToApply); err != nil || !okToApply {
+			c.Infof("Skipping workload %q\n", workload.Name)
+			return false, nil
+		}
+	} else {
+		okToApply = opts.Yes
+	}
+
+	if err := opts.recordApplyHistory(ctx, c, currentWorkload, workload, applyConfig); err != nil {
+		return false, err
+	}
+	if err := c.Patch(ctx, applyConfig, client.Apply, opts.applyPatchOptions()...); err != nil {
+		okToApply = false
+		if apierrs.IsConflict(err) {
+			c.Printf("%s conflict applying workload, some fields are managed by another field manager; run the command again with %s to take ownership of them\n", printer.Serrorf("Error:"), flags.ForceConflictsFlagName)
+			c.Eprintf("%s\n", err)
+			return okToApply, cli.SilenceError(err)
+		}
+		return okToApply, err
+	}
+
+	if currentWorkload == nil {
+		c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Created workload %q\n", workload.Name))
+	} else {
+		c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Updated workload %q\n", workload.Name))
+	}
+	return okToApply, nil
+}
+
 func (opts *WorkloadApplyOptions) IsDryRun() bool {
 	return opts.DryRun
 }
@@ -249,6 +490,8 @@ Workload configuration options include:
 `),
 		Example: strings.Join([]string{
 			fmt.Sprintf("%s workload apply %s workload.yaml", c.Name, flags.FilePathFlagName),
+			fmt.Sprintf("%s workload apply %s workloads.yaml %s", c.Name, flags.FilePathFlagName, flags.YesFlagName),
+			fmt.Sprintf("%s workload apply %s ./config %s", c.Name, flags.FilePathFlagName, flags.RecursiveFlagName),
 		}, "\n"),
 		PreRunE:           cli.ValidateE(ctx, opts),
 		RunE:              cli.ExecE(ctx, c, opts),
@@ -261,6 +504,11 @@ Workload configuration options include:
 
 	// Define common flags
 	opts.DefineFlags(ctx, c, cmd)
+	cmd.Flags().BoolVarP(&opts.Recursive, cli.StripDash(flags.RecursiveFlagName), "R", false, "process the directory used in "+flags.FilePathFlagName+" recursively")
+	cmd.Flags().IntVar(&opts.HistoryLimit, cli.StripDash(flags.HistoryLimitFlagName), 0, "`number` of workload revisions to record in the workload annotations, viewed with \"workload history\" (0 to stop recording, defaults to the number already set for the workload)")
+	cmd.Flags().BoolVar(&opts.ServerSide, cli.StripDash(flags.ServerSideFlagName), false, fmt.Sprintf("submit the workload with a server-side apply patch, only taking ownership of the fields set by the file and flags (field manager %q)", WorkloadFieldManager))
+	cmd.Flags().BoolVar(&opts.ForceConflicts, cli.StripDash(flags.ForceConflictsFlagName), false, "take ownership of fields managed by another field manager when using "+flags.ServerSideFlagName)
+	cmd.Flags().IntVar(&opts.UpdateAttempts, cli.StripDash(flags.UpdateAttemptsFlagName), 3, "maximum number of attempts to update the workload when it is modified by another user at the same time")
 	cmd.Flags().StringVar(&opts.UpdateStrategy, cli.StripDash(flags.UpdateStrategyFlagName), mergeUpdateStrategy, fmt.Sprintf("specify configuration file update strategy (supported strategies: %s, %s)", mergeUpdateStrategy, replaceUpdateStrategy))
 	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.UpdateStrategyFlagName), func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return []string{replaceUpdateStrategy, mergeUpdateStrategy}, cobra.ShellCompDirectiveNoFileComp
@@ -17,6 +17,7 @@ package commands_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	runtm "runtime"
@@ -28,6 +29,7 @@ import (
 	"github.com/Netflix/go-expect"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/mock"
+	rtesting "github.com/vmware-labs/reconciler-runtime/testing"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
@@ -36,6 +38,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -79,6 +82,17 @@ func TestWorkloadApplyOptionsValidate(t *testing.T) {
 			},
 			ExpectFieldErrors: validation.ErrInvalidArrayValue("FOO", flags.EnvFlagName, 0),
 		},
+		{
+			Name: "recursive without filepath",
+			Validatable: &commands.WorkloadApplyOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					Name:      "my-resource",
+					Recursive: true,
+				},
+			},
+			ExpectFieldErrors: validation.ErrMissingField(flags.FilePathFlagName),
+		},
 		{
 			Name: "update strategy without filepath",
 			Validatable: &commands.WorkloadApplyOptions{
@@ -138,6 +152,50 @@ func TestWorkloadApplyOptionsValidate(t *testing.T) {
 			},
 			ExpectFieldErrors: validation.EnumInvalidValue("invalid", flags.UpdateStrategyFlagName, []string{"merge", "replace"}),
 		},
+		{
+			Name: "server side with update strategy",
+			Validatable: &commands.WorkloadApplyOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					Name:      "my-resource",
+					FilePath:  "my-folder/my-filepath.yaml",
+				},
+				UpdateStrategy: "replace",
+				ServerSide:     true,
+			},
+			Prepare: func(t *testing.T, ctx context.Context) (context.Context, error) {
+				cmd := commands.NewWorkloadApplyCommand(ctx, cli.NewDefaultConfig("test", scheme))
+				if err := cmd.Flags().Set(cli.StripDash(flags.UpdateStrategyFlagName), "replace"); err != nil {
+					return ctx, err
+				}
+				ctx = cli.WithCommand(ctx, cmd)
+				return ctx, nil
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.ServerSideFlagName, flags.UpdateStrategyFlagName),
+		},
+		{
+			Name: "force conflicts without server side",
+			Validatable: &commands.WorkloadApplyOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					Name:      "my-resource",
+				},
+				ForceConflicts: true,
+			},
+			ExpectFieldErrors: validation.ErrMissingField(flags.ServerSideFlagName),
+		},
+		{
+			Name: "server side with force conflicts",
+			Validatable: &commands.WorkloadApplyOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					Name:      "my-resource",
+				},
+				ServerSide:     true,
+				ForceConflicts: true,
+			},
+			ShouldValidate: true,
+		},
 	}
 
 	table.Run(t)
@@ -159,6 +217,11 @@ func TestWorkloadApplyCommand(t *testing.T) {
 
 	var cmd *cobra.Command
 
+	// used to change a workload on the cluster while the command is updating it
+	var concurrentConfig *cli.Config
+	concurrentUpdated := false
+	multipleConcurrentUpdated := false
+
 	parent := diecartov1alpha1.WorkloadBlank.
 		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
 			d.Name(workloadName)
@@ -191,6 +254,7 @@ func TestWorkloadApplyCommand(t *testing.T) {
 			Args:         []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.DryRunFlagName, flags.YesFlagName},
 			GivenObjects: givenNamespaceDefault,
 			ExpectOutput: `
+❗ WARNING: workload "my-workload" does not match any cluster supply chain
 ---
 apiVersion: carto.run/v1alpha1
 kind: Workload
@@ -532,7 +596,7 @@ Workload "my-workload" is ready
 
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Minute, false).Return(nil).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Minute, Follow: true}).Return(nil).Once()
 				ctx = logs.StashTailer(ctx, tailer)
 
 				return ctx, nil
@@ -1183,74 +1247,859 @@ status:
 								},
 							},
 						},
-						Env: []corev1.EnvVar{
-							{
-								Name:  "SPRING_PROFILES_ACTIVE",
-								Value: "mysql",
-							},
-						},
-						Resources: &corev1.ResourceRequirements{
-							Limits: corev1.ResourceList{
-								corev1.ResourceCPU:    resource.MustParse("500m"),
-								corev1.ResourceMemory: resource.MustParse("1Gi"),
-							},
-							Requests: corev1.ResourceList{
-								corev1.ResourceCPU:    resource.MustParse("100m"),
-								corev1.ResourceMemory: resource.MustParse("1Gi"),
-							},
-						},
+						Env: []corev1.EnvVar{
+							{
+								Name:  "SPRING_PROFILES_ACTIVE",
+								Value: "mysql",
+							},
+						},
+						Resources: &corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("500m"),
+								corev1.ResourceMemory: resource.MustParse("1Gi"),
+							},
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("100m"),
+								corev1.ResourceMemory: resource.MustParse("1Gi"),
+							},
+						},
+					},
+				},
+			},
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
+
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: spring-petclinic
+      7 + |    apps.tanzu.vmware.com/workload-type: web
+      8 + |  name: spring-petclinic
+      9 + |  namespace: default
+     10 + |spec:
+     11 + |  build:
+     12 + |    env:
+     13 + |    - name: BP_MAVEN_POM_FILE
+     14 + |      value: skip-pom.xml
+     15 + |  env:
+     16 + |  - name: SPRING_PROFILES_ACTIVE
+     17 + |    value: mysql
+     18 + |  resources:
+     19 + |    limits:
+     20 + |      cpu: 500m
+     21 + |      memory: 1Gi
+     22 + |    requests:
+     23 + |      cpu: 100m
+     24 + |      memory: 1Gi
+     25 + |  source:
+     26 + |    git:
+     27 + |      ref:
+     28 + |        branch: main
+     29 + |      url: https://github.com/spring-projects/spring-petclinic.git
+👍 Created workload "spring-petclinic"
+
+To see logs:   "tanzu apps workload tail spring-petclinic --timestamp --since 1h"
+To get status: "tanzu apps workload get spring-petclinic"
+
+`,
+		},
+		{
+			Name:        "fail to accept yaml file through stdin missing --yes flag",
+			Args:        []string{flags.FilePathFlagName, "-"},
+			ShouldError: true,
+		},
+		{
+			Name:        "filepath - missing",
+			Args:        []string{workloadName, flags.FilePathFlagName, "testdata/missing.yaml", flags.YesFlagName},
+			ShouldError: true,
+		},
+		{
+			Name:         "multiple workloads from file",
+			Args:         []string{flags.FilePathFlagName, "testdata/workloads.yaml", flags.YesFlagName},
+			GivenObjects: givenNamespaceDefault,
+			ExpectCreates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "petclinic-api",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName: "petclinic",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Source: &cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: "https://github.com/spring-projects/spring-petclinic.git",
+								Ref: cartov1alpha1.GitRef{
+									Branch: "main",
+								},
+							},
+						},
+					},
+				},
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "petclinic-ui",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName: "petclinic",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "registry.example/petclinic-ui:latest",
+					},
+				},
+			},
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
+
+🔎 Create workload "petclinic-api":
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic-api
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  source:
+     11 + |    git:
+     12 + |      ref:
+     13 + |        branch: main
+     14 + |      url: https://github.com/spring-projects/spring-petclinic.git
+🔎 Create workload "petclinic-ui":
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic-ui
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  image: registry.example/petclinic-ui:latest
+👍 Created workload "petclinic-api"
+👍 Created workload "petclinic-ui"
+`,
+		},
+		{
+			Name: "multiple workloads from file - update existing and collect errors",
+			Args: []string{flags.FilePathFlagName, "testdata/workloads.yaml", flags.EnvFlagName, "FOO=bar", flags.YesFlagName},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("create", "Workload"),
+			},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				diecartov1alpha1.WorkloadBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("petclinic-ui")
+						d.Namespace(defaultNamespace)
+						d.AddLabel(apis.AppPartOfLabelName, "petclinic")
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("registry.example/petclinic-ui:v1")
+					}),
+			},
+			ExpectCreates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "petclinic-api",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName: "petclinic",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Env: []corev1.EnvVar{
+							{
+								Name:  "FOO",
+								Value: "bar",
+							},
+						},
+						Source: &cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: "https://github.com/spring-projects/spring-petclinic.git",
+								Ref: cartov1alpha1.GitRef{
+									Branch: "main",
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectUpdates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "petclinic-ui",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName: "petclinic",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Env: []corev1.EnvVar{
+							{
+								Name:  "FOO",
+								Value: "bar",
+							},
+						},
+						Image: "registry.example/petclinic-ui:latest",
+					},
+				},
+			},
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
+
+🔎 Create workload "petclinic-api":
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic-api
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  env:
+     11 + |  - name: FOO
+     12 + |    value: bar
+     13 + |  source:
+     14 + |    git:
+     15 + |      ref:
+     16 + |        branch: main
+     17 + |      url: https://github.com/spring-projects/spring-petclinic.git
+🔎 Update workload "petclinic-ui":
+...
+  6,  6   |    app.kubernetes.io/part-of: petclinic
+  7,  7   |  name: petclinic-ui
+  8,  8   |  namespace: default
+  9,  9   |spec:
+ 10     - |  image: registry.example/petclinic-ui:v1
+     10 + |  env:
+     11 + |  - name: FOO
+     12 + |    value: bar
+     13 + |  image: registry.example/petclinic-ui:latest
+👍 Updated workload "petclinic-ui"
+
+Error: workload "petclinic-api": inducing failure for create Workload
+`,
+			ShouldError: true,
+		},
+		{
+			Name: "server side apply",
+			Args: []string{workloadName, flags.ImageFlagName, "registry.example/my-workload:v2", flags.ServerSideFlagName, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("registry.example/my-workload:v1")
+						d.EnvDie("FOO", func(d *diecorev1.EnvVarDie) {
+							d.Value("bar")
+						})
+					}),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "carto.run",
+					Kind:      "Workload",
+					Namespace: defaultNamespace,
+					Name:      workloadName,
+					PatchType: types.ApplyPatchType,
+					Patch:     []byte(`{"kind":"Workload","apiVersion":"carto.run/v1alpha1","metadata":{"name":"my-workload","namespace":"default","creationTimestamp":null},"spec":{"image":"registry.example/my-workload:v2"},"status":{"supplyChainRef":{}}}`),
+				},
+			},
+			ExpectOutput: `
+🔎 Update workload:
+...
+  7,  7   |spec:
+  8,  8   |  env:
+  9,  9   |  - name: FOO
+ 10, 10   |    value: bar
+ 11     - |  image: registry.example/my-workload:v1
+     11 + |  image: registry.example/my-workload:v2
+👍 Updated workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name: "server side apply - records history",
+			Args: []string{workloadName, flags.ImageFlagName, "registry.example/my-workload:v2", flags.ServerSideFlagName, flags.HistoryLimitFlagName, "2", flags.YesFlagName},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				return commands.StashRevisionStamp(ctx, "alice", workloadRevisionTimestamp), nil
+			},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("registry.example/my-workload:v1")
+						d.EnvDie("FOO", func(d *diecorev1.EnvVarDie) {
+							d.Value("bar")
+						})
+					}),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "carto.run",
+					Kind:      "Workload",
+					Namespace: defaultNamespace,
+					Name:      workloadName,
+					PatchType: types.ApplyPatchType,
+					Patch: workloadApplyPatch(&cartov1alpha1.Workload{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: defaultNamespace,
+							Name:      workloadName,
+							Annotations: map[string]string{
+								apis.WorkloadHistoryAnnotationName: workloadHistoryAnnotation(&cartov1alpha1.WorkloadHistory{
+									Limit: 2,
+									Revisions: []cartov1alpha1.WorkloadRevision{{
+										Revision: 1,
+										Spec: cartov1alpha1.WorkloadSpec{
+											Image: "registry.example/my-workload:v1",
+											Env:   []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+										},
+									}, {
+										Revision:  2,
+										Author:    "alice",
+										Timestamp: &metav1.Time{Time: workloadRevisionTimestamp},
+										Spec: cartov1alpha1.WorkloadSpec{
+											Image: "registry.example/my-workload:v2",
+											Env:   []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+										},
+									}},
+								}),
+							},
+						},
+						Spec: cartov1alpha1.WorkloadSpec{
+							Image: "registry.example/my-workload:v2",
+						},
+					}),
+				},
+			},
+			ExpectOutput: `
+🔎 Update workload:
+...
+  7,  7   |spec:
+  8,  8   |  env:
+  9,  9   |  - name: FOO
+ 10, 10   |    value: bar
+ 11     - |  image: registry.example/my-workload:v1
+     11 + |  image: registry.example/my-workload:v2
+👍 Updated workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name:         "server side apply - create",
+			Args:         []string{workloadName, flags.ImageFlagName, "registry.example/my-workload:v1", flags.ServerSideFlagName, flags.ForceConflictsFlagName, flags.YesFlagName},
+			GivenObjects: givenNamespaceDefault,
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "carto.run",
+					Kind:      "Workload",
+					Namespace: defaultNamespace,
+					Name:      workloadName,
+					PatchType: types.ApplyPatchType,
+					Patch:     []byte(`{"kind":"Workload","apiVersion":"carto.run/v1alpha1","metadata":{"name":"my-workload","namespace":"default","creationTimestamp":null},"spec":{"image":"registry.example/my-workload:v1"},"status":{"supplyChainRef":{}}}`),
+				},
+			},
+			ExpectOutput: `
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  name: my-workload
+      6 + |  namespace: default
+      7 + |spec:
+      8 + |  image: registry.example/my-workload:v1
+👍 Created workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name: "server side apply - conflict",
+			Args: []string{workloadName, flags.ImageFlagName, "registry.example/my-workload:v2", flags.ServerSideFlagName, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("registry.example/my-workload:v1")
+					}),
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("patch", "Workload", clitesting.InduceFailureOpts{
+					Error: apierrs.NewConflict(cartov1alpha1.Resource("workloads"), workloadName, fmt.Errorf(`Apply failed with 1 conflict: conflict with "kubectl-edit": .spec.image`)),
+				}),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "carto.run",
+					Kind:      "Workload",
+					Namespace: defaultNamespace,
+					Name:      workloadName,
+					PatchType: types.ApplyPatchType,
+					Patch:     []byte(`{"kind":"Workload","apiVersion":"carto.run/v1alpha1","metadata":{"name":"my-workload","namespace":"default","creationTimestamp":null},"spec":{"image":"registry.example/my-workload:v2"},"status":{"supplyChainRef":{}}}`),
+				},
+			},
+			ExpectOutput: `
+🔎 Update workload:
+...
+  4,  4   |metadata:
+  5,  5   |  name: my-workload
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8     - |  image: registry.example/my-workload:v1
+      8 + |  image: registry.example/my-workload:v2
+Error: conflict applying workload, some fields are managed by another field manager; run the command again with --force-conflicts to take ownership of them
+Operation cannot be fulfilled on workloads.carto.run "my-workload": Apply failed with 1 conflict: conflict with "kubectl-edit": .spec.image
+`,
+			ShouldError: true,
+		},
+		{
+			Name:         "server side apply - dry run",
+			Args:         []string{flags.FilePathFlagName, file, flags.ServerSideFlagName, flags.DryRunFlagName},
+			GivenObjects: givenNamespaceDefault,
+			ExpectOutput: `
+❗ WARNING: workload "spring-petclinic" does not match any cluster supply chain
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/part-of: spring-petclinic
+    apps.tanzu.vmware.com/workload-type: web
+  name: spring-petclinic
+  namespace: default
+spec:
+  env:
+  - name: SPRING_PROFILES_ACTIVE
+    value: mysql
+  resources:
+    limits:
+      cpu: 500m
+      memory: 1Gi
+    requests:
+      cpu: 100m
+      memory: 1Gi
+  source:
+    git:
+      ref:
+        branch: main
+      url: https://github.com/spring-projects/spring-petclinic.git
+status:
+  supplyChainRef: {}
+`,
+		},
+		{
+			Name: "multiple workloads from file - conflict during update retried",
+			Args: []string{flags.FilePathFlagName, "testdata/workloads.yaml", flags.UpdateStrategyFlagName, "replace", flags.YesFlagName},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				concurrentConfig = config
+				return ctx, nil
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				func(action clitesting.Action) (bool, runtime.Object, error) {
+					if multipleConcurrentUpdated || !action.Matches("update", "Workload") {
+						return false, nil, nil
+					}
+					multipleConcurrentUpdated = true
+					workload := &cartov1alpha1.Workload{}
+					if err := concurrentConfig.Get(context.Background(), client.ObjectKey{Namespace: defaultNamespace, Name: "petclinic-ui"}, workload); err != nil {
+						return true, nil, err
+					}
+					workload.Status.SupplyChainRef.Name = "source-to-url"
+					return false, nil, concurrentConfig.Update(context.Background(), workload)
+				},
+			},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				diecartov1alpha1.WorkloadBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("petclinic-ui")
+						d.Namespace(defaultNamespace)
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("registry.example/petclinic-ui:v1")
+					}),
+			},
+			ExpectCreates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "petclinic-api",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName: "petclinic",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Source: &cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: "https://github.com/spring-projects/spring-petclinic.git",
+								Ref: cartov1alpha1.GitRef{
+									Branch: "main",
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectUpdates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "petclinic-ui",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName: "petclinic",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "registry.example/petclinic-ui:latest",
+					},
+				},
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "petclinic-ui",
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "registry.example/petclinic-ui:v1",
+					},
+					Status: cartov1alpha1.WorkloadStatus{
+						SupplyChainRef: cartov1alpha1.ObjectReference{
+							Name: "source-to-url",
+						},
+					},
+				},
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "petclinic-ui",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName: "petclinic",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "registry.example/petclinic-ui:latest",
+					},
+				},
+			},
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
+
+🔎 Create workload "petclinic-api":
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic-api
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  source:
+     11 + |    git:
+     12 + |      ref:
+     13 + |        branch: main
+     14 + |      url: https://github.com/spring-projects/spring-petclinic.git
+🔎 Update workload "petclinic-ui":
+  1,  1   |---
+  2,  2   |apiVersion: carto.run/v1alpha1
+  3,  3   |kind: Workload
+  4,  4   |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+  5,  7   |  name: petclinic-ui
+  6,  8   |  namespace: default
+  7,  9   |spec:
+  8     - |  image: registry.example/petclinic-ui:v1
+     10 + |  image: registry.example/petclinic-ui:latest
+👍 Created workload "petclinic-api"
+Workload "petclinic-ui" was modified by another user, retrying update (attempt 2 of 3)
+👍 Updated workload "petclinic-ui"
+`,
+		},
+		{
+			Name: "multiple workloads from file - server side apply",
+			Args: []string{flags.FilePathFlagName, "testdata/workloads.yaml", flags.ServerSideFlagName, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				diecartov1alpha1.WorkloadBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("petclinic-ui")
+						d.Namespace(defaultNamespace)
+						d.AddLabel(apis.AppPartOfLabelName, "petclinic")
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("registry.example/petclinic-ui:v1")
+					}),
+			},
+			ExpectPatches: []rtesting.PatchRef{
+				{
+					Group:     "carto.run",
+					Kind:      "Workload",
+					Namespace: defaultNamespace,
+					Name:      "petclinic-api",
+					PatchType: types.ApplyPatchType,
+					Patch:     []byte(`{"kind":"Workload","apiVersion":"carto.run/v1alpha1","metadata":{"name":"petclinic-api","namespace":"default","creationTimestamp":null,"labels":{"app.kubernetes.io/part-of":"petclinic"}},"spec":{"source":{"git":{"url":"https://github.com/spring-projects/spring-petclinic.git","ref":{"branch":"main"}}}},"status":{"supplyChainRef":{}}}`),
+				},
+				{
+					Group:     "carto.run",
+					Kind:      "Workload",
+					Namespace: defaultNamespace,
+					Name:      "petclinic-ui",
+					PatchType: types.ApplyPatchType,
+					Patch:     []byte(`{"kind":"Workload","apiVersion":"carto.run/v1alpha1","metadata":{"name":"petclinic-ui","namespace":"default","creationTimestamp":null,"labels":{"app.kubernetes.io/part-of":"petclinic"}},"spec":{"image":"registry.example/petclinic-ui:latest"},"status":{"supplyChainRef":{}}}`),
+				},
+			},
+			ExpectOutput: `
+🔎 Create workload "petclinic-api":
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic-api
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  source:
+     11 + |    git:
+     12 + |      ref:
+     13 + |        branch: main
+     14 + |      url: https://github.com/spring-projects/spring-petclinic.git
+🔎 Update workload "petclinic-ui":
+...
+  6,  6   |    app.kubernetes.io/part-of: petclinic
+  7,  7   |  name: petclinic-ui
+  8,  8   |  namespace: default
+  9,  9   |spec:
+ 10     - |  image: registry.example/petclinic-ui:v1
+     10 + |  image: registry.example/petclinic-ui:latest
+👍 Created workload "petclinic-api"
+👍 Updated workload "petclinic-ui"
+`,
+		},
+		{
+			Name:         "multiple workloads from file - dry run",
+			Args:         []string{flags.FilePathFlagName, "testdata/workloads.yaml", flags.DryRunFlagName},
+			GivenObjects: givenNamespaceDefault,
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
+
+❗ WARNING: workload "petclinic-api" does not match any cluster supply chain
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/part-of: petclinic
+  name: petclinic-api
+  namespace: default
+spec:
+  source:
+    git:
+      ref:
+        branch: main
+      url: https://github.com/spring-projects/spring-petclinic.git
+status:
+  supplyChainRef: {}
+❗ WARNING: workload "petclinic-ui" does not match any cluster supply chain
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/part-of: petclinic
+  name: petclinic-ui
+  namespace: default
+spec:
+  image: registry.example/petclinic-ui:latest
+status:
+  supplyChainRef: {}
+`,
+		},
+		{
+			Name: "multiple workloads from file - unchanged",
+			Args: []string{flags.FilePathFlagName, "testdata/workloads.yaml", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				diecartov1alpha1.WorkloadBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("petclinic-ui")
+						d.Namespace(defaultNamespace)
+						d.AddLabel(apis.AppPartOfLabelName, "petclinic")
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("registry.example/petclinic-ui:latest")
+					}),
+			},
+			ExpectCreates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "petclinic-api",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName: "petclinic",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Source: &cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: "https://github.com/spring-projects/spring-petclinic.git",
+								Ref: cartov1alpha1.GitRef{
+									Branch: "main",
+								},
+							},
+						},
 					},
 				},
 			},
 			ExpectOutput: `
 ❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
 
-🔎 Create workload:
+🔎 Create workload "petclinic-api":
       1 + |---
       2 + |apiVersion: carto.run/v1alpha1
       3 + |kind: Workload
       4 + |metadata:
       5 + |  labels:
-      6 + |    app.kubernetes.io/part-of: spring-petclinic
-      7 + |    apps.tanzu.vmware.com/workload-type: web
-      8 + |  name: spring-petclinic
-      9 + |  namespace: default
-     10 + |spec:
-     11 + |  build:
-     12 + |    env:
-     13 + |    - name: BP_MAVEN_POM_FILE
-     14 + |      value: skip-pom.xml
-     15 + |  env:
-     16 + |  - name: SPRING_PROFILES_ACTIVE
-     17 + |    value: mysql
-     18 + |  resources:
-     19 + |    limits:
-     20 + |      cpu: 500m
-     21 + |      memory: 1Gi
-     22 + |    requests:
-     23 + |      cpu: 100m
-     24 + |      memory: 1Gi
-     25 + |  source:
-     26 + |    git:
-     27 + |      ref:
-     28 + |        branch: main
-     29 + |      url: https://github.com/spring-projects/spring-petclinic.git
-👍 Created workload "spring-petclinic"
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic-api
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  source:
+     11 + |    git:
+     12 + |      ref:
+     13 + |        branch: main
+     14 + |      url: https://github.com/spring-projects/spring-petclinic.git
+Workload "petclinic-ui" is unchanged, skipping update
+👍 Created workload "petclinic-api"
+`,
+		},
+		{
+			Name:         "multiple workloads from file with terminal interaction",
+			Args:         []string{flags.FilePathFlagName, "testdata/workloads.yaml"},
+			GivenObjects: givenNamespaceDefault,
+			WithConsoleInteractions: func(t *testing.T, c *expect.Console) {
+				c.ExpectString(clitesting.ToInteractTerminal("Do you want to create these 2 workloads? [yN]: "))
+				c.Send(clitesting.InteractInputLine("n"))
+				c.ExpectString(clitesting.ToInteractOutput("Skipping workloads"))
+			},
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
 
-To see logs:   "tanzu apps workload tail spring-petclinic --timestamp --since 1h"
-To get status: "tanzu apps workload get spring-petclinic"
+🔎 Create workload "petclinic-api":
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic-api
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  source:
+     11 + |    git:
+     12 + |      ref:
+     13 + |        branch: main
+     14 + |      url: https://github.com/spring-projects/spring-petclinic.git
+🔎 Create workload "petclinic-ui":
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic-ui
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  image: registry.example/petclinic-ui:latest
+` + clitesting.ToInteractTerminal("❓ Do you want to create these 2 workloads? [yN]: n") + `
+
+Skipping workloads`,
+		},
+		{
+			Name:         "multiple workloads from directory - dry run",
+			Args:         []string{flags.FilePathFlagName, "testdata/workloads-dir", flags.RecursiveFlagName, flags.DryRunFlagName},
+			GivenObjects: givenNamespaceDefault,
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
 
+❗ WARNING: workload "petclinic-api" does not match any cluster supply chain
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/part-of: petclinic
+  name: petclinic-api
+  namespace: default
+spec:
+  source:
+    git:
+      ref:
+        branch: main
+      url: https://github.com/spring-projects/spring-petclinic.git
+status:
+  supplyChainRef: {}
+❗ WARNING: workload "petclinic-ui" does not match any cluster supply chain
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/part-of: petclinic
+  name: petclinic-ui
+  namespace: default
+spec:
+  image: registry.example/petclinic-ui:latest
+status:
+  supplyChainRef: {}
+❗ WARNING: workload "petclinic-worker" does not match any cluster supply chain
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/part-of: petclinic
+  name: petclinic-worker
+  namespace: default
+spec:
+  image: registry.example/petclinic-worker:latest
+status:
+  supplyChainRef: {}
 `,
 		},
 		{
-			Name:        "fail to accept yaml file through stdin missing --yes flag",
-			Args:        []string{flags.FilePathFlagName, "-"},
-			ShouldError: true,
+			Name:         "multiple workloads from file - name arg",
+			Args:         []string{workloadName, flags.FilePathFlagName, "testdata/workloads.yaml", flags.YesFlagName},
+			GivenObjects: givenNamespaceDefault,
+			ShouldError:  true,
 		},
 		{
-			Name:        "filepath - missing",
-			Args:        []string{workloadName, flags.FilePathFlagName, "testdata/missing.yaml", flags.YesFlagName},
-			ShouldError: true,
+			Name:         "multiple workloads from file - wait",
+			Args:         []string{flags.FilePathFlagName, "testdata/workloads.yaml", flags.WaitFlagName, flags.YesFlagName},
+			GivenObjects: givenNamespaceDefault,
+			ShouldError:  true,
 		},
 		{
 			Name: "noop",
@@ -1290,6 +2139,7 @@ Workload is unchanged, skipping update
 					}),
 			},
 			ExpectOutput: `
+❗ WARNING: workload "my-workload" does not match any cluster supply chain
 ---
 apiVersion: carto.run/v1alpha1
 kind: Workload
@@ -1341,7 +2191,7 @@ status:
 		},
 		{
 			Name: "conflict during update",
-			Args: []string{workloadName, flags.DebugFlagName, flags.YesFlagName},
+			Args: []string{workloadName, flags.DebugFlagName, flags.UpdateAttemptsFlagName, "1", flags.YesFlagName},
 			WithReactors: []clitesting.ReactionFunc{
 				clitesting.InduceFailure("update", "Workload", clitesting.InduceFailureOpts{
 					Error: apierrs.NewConflict(schema.GroupResource{Group: "carto.run", Resource: "workloads"}, workloadName, fmt.Errorf("induced conflict")),
@@ -1383,6 +2233,173 @@ status:
      10 + |  - name: debug
      11 + |    value: "true"
 Error: conflict updating workload, the object was modified by another user; please run the update command again
+`,
+		},
+		{
+			Name: "conflict during update - retried with file",
+			Args: []string{flags.FilePathFlagName, file, flags.UpdateStrategyFlagName, "replace", flags.EnvFlagName, "FOO=bar", flags.YesFlagName},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				concurrentConfig = config
+				return ctx, nil
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				func(action clitesting.Action) (bool, runtime.Object, error) {
+					if concurrentUpdated || !action.Matches("update", "Workload") {
+						return false, nil, nil
+					}
+					concurrentUpdated = true
+					workload := &cartov1alpha1.Workload{}
+					if err := concurrentConfig.Get(context.Background(), client.ObjectKey{Namespace: defaultNamespace, Name: "spring-petclinic"}, workload); err != nil {
+						return true, nil, err
+					}
+					workload.Status.SupplyChainRef.Name = "source-to-url"
+					return false, nil, concurrentConfig.Update(context.Background(), workload)
+				},
+			},
+			GivenObjects: []client.Object{
+				diecartov1alpha1.WorkloadBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("spring-petclinic")
+						d.Namespace(defaultNamespace)
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("ubuntu:bionic")
+					}),
+			},
+			ExpectUpdates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "spring-petclinic",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName:    "spring-petclinic",
+							apis.WorkloadTypeLabelName: "web",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Env: []corev1.EnvVar{
+							{
+								Name:  "SPRING_PROFILES_ACTIVE",
+								Value: "mysql",
+							},
+							{
+								Name:  "FOO",
+								Value: "bar",
+							},
+						},
+						Resources: &corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("500m"),
+								corev1.ResourceMemory: resource.MustParse("1Gi"),
+							},
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("100m"),
+								corev1.ResourceMemory: resource.MustParse("1Gi"),
+							},
+						},
+						Source: &cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: "https://github.com/spring-projects/spring-petclinic.git",
+								Ref: cartov1alpha1.GitRef{
+									Branch: "main",
+								},
+							},
+						},
+					},
+				},
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "spring-petclinic",
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:bionic",
+					},
+					Status: cartov1alpha1.WorkloadStatus{
+						SupplyChainRef: cartov1alpha1.ObjectReference{
+							Name: "source-to-url",
+						},
+					},
+				},
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "spring-petclinic",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName:    "spring-petclinic",
+							apis.WorkloadTypeLabelName: "web",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Env: []corev1.EnvVar{
+							{
+								Name:  "SPRING_PROFILES_ACTIVE",
+								Value: "mysql",
+							},
+							{
+								Name:  "FOO",
+								Value: "bar",
+							},
+						},
+						Resources: &corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("500m"),
+								corev1.ResourceMemory: resource.MustParse("1Gi"),
+							},
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("100m"),
+								corev1.ResourceMemory: resource.MustParse("1Gi"),
+							},
+						},
+						Source: &cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: "https://github.com/spring-projects/spring-petclinic.git",
+								Ref: cartov1alpha1.GitRef{
+									Branch: "main",
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
+
+🔎 Update workload:
+  1,  1   |---
+  2,  2   |apiVersion: carto.run/v1alpha1
+  3,  3   |kind: Workload
+  4,  4   |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: spring-petclinic
+      7 + |    apps.tanzu.vmware.com/workload-type: web
+  5,  8   |  name: spring-petclinic
+  6,  9   |  namespace: default
+  7, 10   |spec:
+  8     - |  image: ubuntu:bionic
+     11 + |  env:
+     12 + |  - name: SPRING_PROFILES_ACTIVE
+     13 + |    value: mysql
+     14 + |  - name: FOO
+     15 + |    value: bar
+     16 + |  resources:
+     17 + |    limits:
+     18 + |      cpu: 500m
+     19 + |      memory: 1Gi
+     20 + |    requests:
+     21 + |      cpu: 100m
+     22 + |      memory: 1Gi
+     23 + |  source:
+     24 + |    git:
+     25 + |      ref:
+     26 + |        branch: main
+     27 + |      url: https://github.com/spring-projects/spring-petclinic.git
+Workload "spring-petclinic" was modified by another user, retrying update (attempt 2 of 3)
+👍 Updated workload "spring-petclinic"
+
+To see logs:   "tanzu apps workload tail spring-petclinic --timestamp --since 1h"
+To get status: "tanzu apps workload get spring-petclinic"
+
 `,
 		},
 		{
@@ -1634,7 +2651,7 @@ Workload "my-workload" is ready
 
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Minute, true).Return(nil).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Minute, Follow: true, Timestamps: true}).Return(nil).Once()
 				ctx = logs.StashTailer(ctx, tailer)
 
 				return ctx, nil
@@ -5347,3 +6364,13 @@ To get status: "tanzu apps workload get spring-petclinic"
 		return cmd
 	})
 }
+
+// workloadApplyPatch is the server-side apply patch submitted for the workload
+func workloadApplyPatch(workload *cartov1alpha1.Workload) []byte {
+	workload.APIVersion, workload.Kind = cartov1alpha1.SchemeGroupVersion.WithKind(cartov1alpha1.WorkloadKind).ToAPIVersionAndKind()
+	patch, err := json.Marshal(workload)
+	if err != nil {
+		panic(err)
+	}
+	return patch
+}
@@ -57,9 +57,14 @@ func (opts *WorkloadCreateOptions) Exec(ctx context.Context, c *cli.Config) erro
 	workload := &cartov1alpha1.Workload{}
 
 	if opts.FilePath != "" {
-		if err := opts.WorkloadOptions.LoadInputWorkload(c.Stdin, workload); err != nil {
+		fileWorkloads, err := opts.WorkloadOptions.LoadInputWorkloads(c.Stdin)
+		if err != nil {
 			return err
 		}
+		if len(fileWorkloads) > 1 {
+			return opts.createWorkloads(ctx, c, fileWorkloads)
+		}
+		workload = fileWorkloads[0]
 	}
 
 	if opts.Name != "" {
@@ -106,7 +111,15 @@ func (opts *WorkloadCreateOptions) Exec(ctx context.Context, c *cli.Config) erro
 		return err
 	}
 
+	if opts.StrictParams {
+		if err := checkStrictParams(ctx, c, workload); err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Error:"), err)
+			return cli.SilenceError(err)
+		}
+	}
+
 	if opts.DryRun {
+		printSupplyChainMatch(ctx, c, workload)
 		cli.DryRunResource(ctx, workload, workload.GetGroupVersionKind())
 		return nil
 	}
@@ -149,8 +162,12 @@ func (opts *WorkloadCreateOptions) Exec(ctx context.Context, c *cli.Config) erro
 				if err != nil {
 					panic(err)
 				}
-				containers := []string{}
-				return logs.Tail(ctx, c, opts.Namespace, selector, containers, time.Minute, opts.TailTimestamps)
+				return logs.Tail(ctx, c, opts.Namespace, selector, logs.TailOptions{
+					Containers: []string{},
+					Since:      time.Minute,
+					Follow:     true,
+					Timestamps: opts.TailTimestamps,
+				})
 			})
 		}
 
@@ -168,6 +185,63 @@ func (opts *WorkloadCreateOptions) Exec(ctx context.Context, c *cli.Config) erro
 	return nil
 }
 
+// createWorkloads creates every workload described in a multi document input file.
+func (opts *WorkloadCreateOptions) createWorkloads(ctx context.Context, c *cli.Config, fileWorkloads []*cartov1alpha1.Workload) error {
+	if err := opts.validateMultipleWorkloads().ToAggregate(); err != nil {
+		return err
+	}
+
+	changes := []workloadChange{}
+	failures := []error{}
+	for i, fileWorkload := range fileWorkloads {
+		change, err := opts.prepareWorkload(ctx, c, fileWorkload)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("%s: %w", workloadDocumentName(fileWorkload, i), err))
+			continue
+		}
+		if opts.StrictParams {
+			if err := checkStrictParams(ctx, c, change.workload); err != nil {
+				failures = append(failures, fmt.Errorf("%s: %w", workloadDocumentName(fileWorkload, i), err))
+				continue
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	if opts.DryRun {
+		for _, change := range changes {
+			printSupplyChainMatch(ctx, c, change.workload)
+			cli.DryRunResource(ctx, change.workload, change.workload.GetGroupVersionKind())
+		}
+		return reportWorkloadFailures(c, failures)
+	}
+
+	return opts.submitWorkloads(ctx, c, changes, failures)
+}
+
+func (opts *WorkloadCreateOptions) prepareWorkload(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) (workloadChange, error) {
+	workload.Namespace = opts.resolveWorkloadNamespace(ctx, workload)
+	if err := validation.K8sName(workload.Name, cli.NameArgumentName).ToAggregate(); err != nil {
+		return workloadChange{}, err
+	}
+
+	existingWorkload := &cartov1alpha1.Workload{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: workload.Namespace, Name: workload.Name}, existingWorkload); err == nil {
+		return workloadChange{}, fmt.Errorf("workload already exists")
+	} else if !apierrs.IsNotFound(err) {
+		return workloadChange{}, err
+	} else if _, nsErr := loadNamespace(ctx, c, workload.Namespace); nsErr != nil {
+		return workloadChange{}, fmt.Errorf("namespace %q not found, it may not exist or user does not have permissions to read it", workload.Namespace)
+	}
+
+	ctx = opts.ApplyOptionsToWorkload(ctx, workload)
+	if err := workload.Validate().ToAggregate(); err != nil {
+		return workloadChange{}, err
+	}
+
+	return workloadChange{workload: workload, notices: workload.GetNotices(ctx)}, nil
+}
+
 func (opts *WorkloadCreateOptions) IsDryRun() bool {
 	return opts.DryRun
 }
@@ -192,6 +266,7 @@ Workload configuration options include:
 			fmt.Sprintf("%s workload create my-workload %s https://example.com/my-workload.git", c.Name, flags.GitRepoFlagName),
 			fmt.Sprintf("%s workload create my-workload %s . %s registry.example/repository:tag", c.Name, flags.LocalPathFlagName, flags.SourceImageFlagName),
 			fmt.Sprintf("%s workload create %s workload.yaml", c.Name, flags.FilePathFlagName),
+			fmt.Sprintf("%s workload create %s 'apps/*/workload.yaml'", c.Name, flags.FilePathFlagName),
 		}, "\n"),
 		PreRunE: cli.ValidateE(ctx, opts),
 		RunE:    cli.ExecE(ctx, c, opts),
@@ -203,6 +278,8 @@ Workload configuration options include:
 
 	// Define common flags
 	opts.DefineFlags(ctx, c, cmd)
+	cmd.Flags().BoolVarP(&opts.Recursive, cli.StripDash(flags.RecursiveFlagName), "R", false, "process the directory used in "+flags.FilePathFlagName+" recursively")
+	cmd.Flags().IntVar(&opts.HistoryLimit, cli.StripDash(flags.HistoryLimitFlagName), 0, "`number` of workload revisions to record in the workload annotations, viewed with \"workload history\" (0 to stop recording, defaults to the number already set for the workload)")
 
 	// Bind flags to environment variables
 	opts.DefineEnvVars(ctx, c, cmd)
@@ -33,6 +33,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
@@ -112,6 +113,41 @@ func TestWorkloadCreateCommand(t *testing.T) {
 			}),
 	}
 
+	strictParamsSupplyChain := diecartov1alpha1.ClusterSupplyChainBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("source-to-url")
+		}).
+		SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+			d.Selector(map[string]string{"apps.tanzu.vmware.com/workload-type": "web"})
+			d.Resources(cartov1alpha1.SupplyChainResource{
+				Name: "source-provider",
+				TemplateRef: cartov1alpha1.SupplyChainTemplateReference{
+					Kind: "ClusterSourceTemplate",
+					Name: "source-template",
+				},
+			})
+		})
+	strictParamsSourceTemplate := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "carto.run/v1alpha1",
+			"kind":       "ClusterSourceTemplate",
+			"metadata": map[string]interface{}{
+				"name": "source-template",
+			},
+			"spec": map[string]interface{}{
+				"params": []interface{}{
+					map[string]interface{}{
+						"name":    "gitImplementation",
+						"default": "go-git",
+					},
+					map[string]interface{}{
+						"name": "gitops_ssh_secret",
+					},
+				},
+			},
+		},
+	}
+
 	table := clitesting.CommandTestSuite{
 		{
 			Name:        "invalid args",
@@ -128,11 +164,204 @@ func TestWorkloadCreateCommand(t *testing.T) {
 			Args:         []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.DryRunFlagName, flags.YesFlagName},
 			GivenObjects: givenNamespaceDefault,
 			ExpectOutput: `
+❗ WARNING: workload "my-workload" does not match any cluster supply chain
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  name: my-workload
+  namespace: default
+spec:
+  source:
+    git:
+      ref:
+        branch: main
+      url: https://example.com/repo.git
+status:
+  supplyChainRef: {}
+`,
+		},
+		{
+			Name: "dry run matching a supply chain",
+			Args: []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.TypeFlagName, "web", flags.DryRunFlagName, flags.YesFlagName},
+			GivenObjects: append(givenNamespaceDefault,
+				diecartov1alpha1.ClusterSupplyChainBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("source-to-url")
+					}).
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.Selector(map[string]string{"apps.tanzu.vmware.com/workload-type": "web"})
+						d.SelectorMatchFields(cartov1alpha1.FieldSelectorRequirement{
+							Key:      "spec.source.git",
+							Operator: cartov1alpha1.FieldSelectorOpExists,
+						})
+					}),
+				diecartov1alpha1.ClusterSupplyChainBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("basic-image-to-url")
+					}).
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.Selector(map[string]string{"apps.tanzu.vmware.com/workload-type": "web"})
+						d.SelectorMatchFields(cartov1alpha1.FieldSelectorRequirement{
+							Key:      "spec.image",
+							Operator: cartov1alpha1.FieldSelectorOpExists,
+						})
+					}),
+				diecartov1alpha1.ClusterSupplyChainBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("worker")
+					}).
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.Selector(map[string]string{"apps.tanzu.vmware.com/workload-type": "worker"})
+					}),
+			),
+			ExpectOutput: `
+Workload "my-workload" matches cluster supply chain "source-to-url"
 ---
 apiVersion: carto.run/v1alpha1
 kind: Workload
 metadata:
   creationTimestamp: null
+  labels:
+    apps.tanzu.vmware.com/workload-type: web
+  name: my-workload
+  namespace: default
+spec:
+  source:
+    git:
+      ref:
+        branch: main
+      url: https://example.com/repo.git
+status:
+  supplyChainRef: {}
+`,
+		},
+		{
+			Name: "dry run matching multiple supply chains",
+			Args: []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.TypeFlagName, "web", flags.DryRunFlagName, flags.YesFlagName},
+			GivenObjects: append(givenNamespaceDefault,
+				diecartov1alpha1.ClusterSupplyChainBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("source-to-url")
+					}).
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.Selector(map[string]string{"apps.tanzu.vmware.com/workload-type": "web"})
+					}),
+				diecartov1alpha1.ClusterSupplyChainBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("web")
+					}).
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.Selector(map[string]string{"apps.tanzu.vmware.com/workload-type": "web"})
+					}),
+			),
+			ExpectOutput: `
+❗ WARNING: workload "my-workload" matches multiple cluster supply chains ("source-to-url", "web"), it will not be taken by any of them
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    apps.tanzu.vmware.com/workload-type: web
+  name: my-workload
+  namespace: default
+spec:
+  source:
+    git:
+      ref:
+        branch: main
+      url: https://example.com/repo.git
+status:
+  supplyChainRef: {}
+`,
+		},
+		{
+			Name: "strict params",
+			Args: []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.TypeFlagName, "web", flags.ParamFlagName, "gitops_ssh_secret=my-secret", flags.StrictParamsFlagName, flags.DryRunFlagName, flags.YesFlagName},
+			GivenObjects: append(givenNamespaceDefault,
+				strictParamsSupplyChain,
+				strictParamsSourceTemplate,
+			),
+			ExpectOutput: `
+Params of workload "my-workload" for cluster supply chain "source-to-url"
+   RESOURCE          PARAM               VALUE         SOURCE
+   source-provider   gitImplementation   "go-git"      template default
+   source-provider   gitops_ssh_secret   "my-secret"   workload
+Workload "my-workload" matches cluster supply chain "source-to-url"
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    apps.tanzu.vmware.com/workload-type: web
+  name: my-workload
+  namespace: default
+spec:
+  params:
+  - name: gitops_ssh_secret
+    value: my-secret
+  source:
+    git:
+      ref:
+        branch: main
+      url: https://example.com/repo.git
+status:
+  supplyChainRef: {}
+`,
+		},
+		{
+			Name: "strict params with a param no template declares",
+			Args: []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.TypeFlagName, "web", flags.ParamFlagName, "gitops_ssh_secrt=my-secret", flags.StrictParamsFlagName, flags.YesFlagName},
+			GivenObjects: append(givenNamespaceDefault,
+				strictParamsSupplyChain,
+				strictParamsSourceTemplate,
+			),
+			ShouldError: true,
+			ExpectOutput: `
+Params of workload "my-workload" for cluster supply chain "source-to-url"
+   RESOURCE          PARAM               VALUE      SOURCE
+   source-provider   gitImplementation   "go-git"   template default
+   source-provider   gitops_ssh_secret   <empty>    template default
+Error: params "gitops_ssh_secrt" of workload "my-workload" are not declared by any template of cluster supply chain "source-to-url"
+`,
+		},
+		{
+			Name:         "strict params without a matching supply chain",
+			Args:         []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.StrictParamsFlagName, flags.YesFlagName},
+			GivenObjects: append(givenNamespaceDefault, strictParamsSupplyChain),
+			ShouldError:  true,
+			ExpectOutput: `
+Error: unable to validate params of workload "my-workload", it must match exactly one cluster supply chain but matches 0
+`,
+		},
+		{
+			Name:         "strict params with a missing template",
+			Args:         []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.TypeFlagName, "web", flags.StrictParamsFlagName, flags.YesFlagName},
+			GivenObjects: append(givenNamespaceDefault, strictParamsSupplyChain),
+			ShouldError:  true,
+			ExpectOutput: `
+Error: template ClusterSourceTemplate "source-template" of resource "source-provider" not found
+`,
+		},
+		{
+			Name:         "dry run failing to list supply chains",
+			Args:         []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.TypeFlagName, "web", flags.DryRunFlagName, flags.YesFlagName},
+			GivenObjects: givenNamespaceDefault,
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "ClusterSupplyChainList"),
+			},
+			ExpectOutput: `
+❗ WARNING: unable to list cluster supply chains to match workload "my-workload": inducing failure for list ClusterSupplyChainList
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    apps.tanzu.vmware.com/workload-type: web
   name: my-workload
   namespace: default
 spec:
@@ -376,7 +605,7 @@ Workload "my-workload" is ready
 
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Minute, false).Return(nil).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Minute, Follow: true}).Return(nil).Once()
 				ctx = logs.StashTailer(ctx, tailer)
 
 				return ctx, nil
@@ -455,7 +684,7 @@ Workload "my-workload" is ready
 
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Minute, true).Return(nil).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Minute, Follow: true, Timestamps: true}).Return(nil).Once()
 				ctx = logs.StashTailer(ctx, tailer)
 
 				return ctx, nil
@@ -739,6 +968,203 @@ spec:
 			Args:        []string{workloadName, flags.FilePathFlagName, "testdata/missing.yaml", flags.YesFlagName},
 			ShouldError: true,
 		},
+		{
+			Name:         "multiple workloads from file",
+			Args:         []string{flags.FilePathFlagName, "testdata/workloads.yaml", flags.YesFlagName},
+			GivenObjects: givenNamespaceDefault,
+			ExpectCreates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "petclinic-api",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName: "petclinic",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Source: &cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: "https://github.com/spring-projects/spring-petclinic.git",
+								Ref: cartov1alpha1.GitRef{
+									Branch: "main",
+								},
+							},
+						},
+					},
+				},
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "petclinic-ui",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName: "petclinic",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "registry.example/petclinic-ui:latest",
+					},
+				},
+			},
+			ExpectOutput: `
+🔎 Create workload "petclinic-api":
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic-api
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  source:
+     11 + |    git:
+     12 + |      ref:
+     13 + |        branch: main
+     14 + |      url: https://github.com/spring-projects/spring-petclinic.git
+🔎 Create workload "petclinic-ui":
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic-ui
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  image: registry.example/petclinic-ui:latest
+👍 Created workload "petclinic-api"
+👍 Created workload "petclinic-ui"
+`,
+		},
+		{
+			Name: "multiple workloads from file - existing workload",
+			Args: []string{flags.FilePathFlagName, "testdata/workloads.yaml", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				diecartov1alpha1.WorkloadBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("petclinic-api")
+						d.Namespace(defaultNamespace)
+					}),
+			},
+			ExpectCreates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      "petclinic-ui",
+						Labels: map[string]string{
+							apis.AppPartOfLabelName: "petclinic",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "registry.example/petclinic-ui:latest",
+					},
+				},
+			},
+			ExpectOutput: `
+🔎 Create workload "petclinic-ui":
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic-ui
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  image: registry.example/petclinic-ui:latest
+👍 Created workload "petclinic-ui"
+
+Error: workload "petclinic-api": workload already exists
+`,
+			ShouldError: true,
+		},
+		{
+			Name:         "multiple workloads from file - dry run",
+			Args:         []string{flags.FilePathFlagName, "testdata/workloads.yaml", flags.DryRunFlagName},
+			GivenObjects: givenNamespaceDefault,
+			ExpectOutput: `
+❗ WARNING: workload "petclinic-api" does not match any cluster supply chain
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/part-of: petclinic
+  name: petclinic-api
+  namespace: default
+spec:
+  source:
+    git:
+      ref:
+        branch: main
+      url: https://github.com/spring-projects/spring-petclinic.git
+status:
+  supplyChainRef: {}
+❗ WARNING: workload "petclinic-ui" does not match any cluster supply chain
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/part-of: petclinic
+  name: petclinic-ui
+  namespace: default
+spec:
+  image: registry.example/petclinic-ui:latest
+status:
+  supplyChainRef: {}
+`,
+		},
+		{
+			Name: "multiple workloads from stdin - missing --yes flag",
+			Args: []string{flags.FilePathFlagName, "-"},
+			Stdin: []byte(`
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  name: petclinic-api
+spec:
+  image: registry.example/petclinic-api:latest
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  name: petclinic-ui
+spec:
+  image: registry.example/petclinic-ui:latest
+`),
+			GivenObjects: givenNamespaceDefault,
+			ExpectOutput: `
+🔎 Create workload "petclinic-api":
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  name: petclinic-api
+      6 + |  namespace: default
+      7 + |spec:
+      8 + |  image: registry.example/petclinic-api:latest
+🔎 Create workload "petclinic-ui":
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  name: petclinic-ui
+      6 + |  namespace: default
+      7 + |spec:
+      8 + |  image: registry.example/petclinic-ui:latest
+Skipping workloads, cannot confirm intent. Run command with --yes flag to confirm intent when providing input from stdin
+`,
+		},
+		{
+			Name:         "multiple workloads from file - local path",
+			Args:         []string{flags.FilePathFlagName, "testdata/workloads.yaml", flags.LocalPathFlagName, "testdata/local-source", flags.YesFlagName},
+			GivenObjects: givenNamespaceDefault,
+			ShouldError:  true,
+		},
 		{
 			Name:        "filepath invalid name",
 			Args:        []string{flags.FilePathFlagName, "testdata/workload-invalid-name.yaml", flags.YesFlagName},
@@ -786,6 +1212,52 @@ spec:
 				},
 			},
 		},
+		{
+			Name: "create records history",
+			Args: []string{workloadName, flags.ImageFlagName, "ubuntu:bionic", flags.HistoryLimitFlagName, "3", flags.YesFlagName},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				return commands.StashRevisionStamp(ctx, "alice", workloadRevisionTimestamp), nil
+			},
+			GivenObjects: givenNamespaceDefault,
+			ExpectCreates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Annotations: map[string]string{
+							apis.WorkloadHistoryAnnotationName: workloadHistoryAnnotation(&cartov1alpha1.WorkloadHistory{
+								Limit: 3,
+								Revisions: []cartov1alpha1.WorkloadRevision{{
+									Revision:  1,
+									Author:    "alice",
+									Timestamp: &metav1.Time{Time: workloadRevisionTimestamp},
+									Spec:      cartov1alpha1.WorkloadSpec{Image: "ubuntu:bionic"},
+								}},
+							}),
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:bionic",
+					},
+				},
+			},
+			ExpectOutput: `
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  name: my-workload
+      6 + |  namespace: default
+      7 + |spec:
+      8 + |  image: ubuntu:bionic
+👍 Created workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
 		{
 			Name:         "create with serviceAccountName specifying other flags from cli",
 			Args:         []string{flags.FilePathFlagName, "testdata/service-account-name.yaml", flags.GitTagFlagName, "tap-1.2", flags.TypeFlagName, "whatever", flags.YesFlagName},
@@ -0,0 +1,173 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+type WorkloadDiffOptions struct {
+	WorkloadApplyOptions
+}
+
+var (
+	_ validation.Validatable = (*WorkloadDiffOptions)(nil)
+	_ cli.Executable         = (*WorkloadDiffOptions)(nil)
+)
+
+// workloadDiffUnsupportedFlags are the workload flags that only make sense for commands that
+// submit changes to the cluster.
+var workloadDiffUnsupportedFlags = []string{
+	flags.LocalPathFlagName,
+	flags.WaitFlagName,
+	flags.WaitTimeoutFlagName,
+	flags.TailFlagName,
+	flags.TailTimestampFlagName,
+	flags.DryRunFlagName,
+	flags.YesFlagName,
+	flags.StrictParamsFlagName,
+}
+
+func (opts *WorkloadDiffOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+	errs = errs.Also(opts.WorkloadApplyOptions.Validate(ctx))
+
+	if opts.FilePath == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.FilePathFlagName))
+	}
+	if opts.Name != "" {
+		errs = errs.Also(validation.K8sName(opts.Name, cli.NameArgumentName))
+	}
+
+	cmd := cli.CommandFromContext(ctx)
+	for _, flag := range workloadDiffUnsupportedFlags {
+		if cmd != nil && cmd.Flags().Changed(cli.StripDash(flag)) {
+			errs = errs.Also(validation.ErrDisallowedFields(flag, "not supported by workload diff"))
+		}
+	}
+
+	return errs
+}
+
+func (opts *WorkloadDiffOptions) Exec(ctx context.Context, c *cli.Config) error {
+	fileWorkloads, err := opts.LoadInputWorkloads(c.Stdin)
+	if err != nil {
+		return err
+	}
+	if len(fileWorkloads) > 1 {
+		if err := opts.validateMultipleWorkloads().ToAggregate(); err != nil {
+			return err
+		}
+	} else if opts.Name != "" {
+		fileWorkloads[0].Name = opts.Name
+	}
+
+	drift := 0
+	failures := []error{}
+	for i, fileWorkload := range fileWorkloads {
+		change, err := opts.prepareWorkload(ctx, c, fileWorkload)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("%s: %w", workloadDocumentName(fileWorkload, i), err))
+			continue
+		}
+
+		difference, noChange, err := workloadDiff(change.current, change.workload, c)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("workload %q: %w", change.workload.Name, err))
+			continue
+		}
+		if noChange {
+			c.Infof("Workload %q is unchanged\n", change.workload.Name)
+			continue
+		}
+
+		drift++
+		if change.current == nil {
+			c.Emoji(cli.Magnifying, "Workload %q does not exist on the cluster:\n", change.workload.Name)
+		} else {
+			c.Emoji(cli.Magnifying, "Workload %q differs from the cluster:\n", change.workload.Name)
+		}
+		c.Printf("%s", difference)
+	}
+
+	if err := reportWorkloadFailures(c, failures); err != nil {
+		return err
+	}
+	if drift != 0 {
+		return cli.SilenceError(fmt.Errorf("%d workloads differ from the cluster", drift))
+	}
+	return nil
+}
+
+func NewWorkloadDiffCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadDiffOptions{}
+	opts.LoadDefaults(c)
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the difference between a workload file and the workload on the cluster",
+		Long: strings.TrimSpace(`
+Show the difference between the workload that would result from running "workload apply" with
+the same file and flags and the workload currently on the cluster. Nothing is submitted to the
+cluster.
+
+The command exits with a non-zero status when any workload differs from the cluster, or does not
+exist yet, so it can be used to detect changes that were made outside of the provided files.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload diff %s workload.yaml", c.Name, flags.FilePathFlagName),
+			fmt.Sprintf("%s workload diff %s workload.yaml %s %s", c.Name, flags.FilePathFlagName, flags.UpdateStrategyFlagName, replaceUpdateStrategy),
+			fmt.Sprintf("%s workload diff %s ./config %s", c.Name, flags.FilePathFlagName, flags.RecursiveFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.OptionalNameArg(&opts.Name),
+	)
+
+	// Define common flags
+	opts.DefineFlags(ctx, c, cmd)
+	cmd.Flags().BoolVarP(&opts.Recursive, cli.StripDash(flags.RecursiveFlagName), "R", false, "process the directory used in "+flags.FilePathFlagName+" recursively")
+	cmd.Flags().StringVar(&opts.UpdateStrategy, cli.StripDash(flags.UpdateStrategyFlagName), mergeUpdateStrategy, fmt.Sprintf("specify configuration file update strategy (supported strategies: %s, %s)", mergeUpdateStrategy, replaceUpdateStrategy))
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.UpdateStrategyFlagName), func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{replaceUpdateStrategy, mergeUpdateStrategy}, cobra.ShellCompDirectiveNoFileComp
+	})
+	for _, flag := range workloadDiffUnsupportedFlags {
+		cmd.Flags().MarkHidden(cli.StripDash(flag))
+	}
+	// registry flags are only used to publish local source code and may be set from env vars
+	for _, flag := range []string{flags.RegistryCertFlagName, flags.RegistryPasswordFlagName, flags.RegistryTokenFlagName, flags.RegistryUsernameFlagName} {
+		cmd.Flags().MarkHidden(cli.StripDash(flag))
+	}
+
+	// Bind flags to environment variables
+	opts.DefineEnvVars(ctx, c, cmd)
+
+	return cmd
+}
@@ -0,0 +1,325 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"context"
+	"testing"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestWorkloadDiffOptionsValidate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name: "valid options",
+			Validatable: &commands.WorkloadDiffOptions{
+				WorkloadApplyOptions: commands.WorkloadApplyOptions{
+					WorkloadOptions: commands.WorkloadOptions{
+						Namespace: "default",
+						FilePath:  "workload.yaml",
+					},
+				},
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "missing file path",
+			Validatable: &commands.WorkloadDiffOptions{
+				WorkloadApplyOptions: commands.WorkloadApplyOptions{
+					WorkloadOptions: commands.WorkloadOptions{
+						Namespace: "default",
+						Name:      "my-workload",
+					},
+				},
+			},
+			ExpectFieldErrors: validation.ErrMissingField(flags.FilePathFlagName),
+		},
+		{
+			Name: "invalid name",
+			Validatable: &commands.WorkloadDiffOptions{
+				WorkloadApplyOptions: commands.WorkloadApplyOptions{
+					WorkloadOptions: commands.WorkloadOptions{
+						Namespace: "default",
+						Name:      "My-Workload",
+						FilePath:  "workload.yaml",
+					},
+				},
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("My-Workload", cli.NameArgumentName),
+		},
+		{
+			Name: "unsupported flags",
+			Validatable: &commands.WorkloadDiffOptions{
+				WorkloadApplyOptions: commands.WorkloadApplyOptions{
+					WorkloadOptions: commands.WorkloadOptions{
+						Namespace: "default",
+						FilePath:  "workload.yaml",
+						Wait:      true,
+						Yes:       true,
+					},
+				},
+			},
+			Prepare: func(t *testing.T, ctx context.Context) (context.Context, error) {
+				cmd := commands.NewWorkloadDiffCommand(ctx, cli.NewDefaultConfig("test", scheme))
+				if err := cmd.Flags().Set(cli.StripDash(flags.WaitFlagName), "true"); err != nil {
+					return ctx, err
+				}
+				if err := cmd.Flags().Set(cli.StripDash(flags.YesFlagName), "true"); err != nil {
+					return ctx, err
+				}
+				return cli.WithCommand(ctx, cmd), nil
+			},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrDisallowedFields(flags.WaitFlagName, "not supported by workload diff"),
+				validation.ErrDisallowedFields(flags.YesFlagName, "not supported by workload diff"),
+			),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadDiffCommand(t *testing.T) {
+	defaultNamespace := "default"
+	file := "testdata/workloads.yaml"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	namespace := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+	api := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("petclinic-api")
+			d.Namespace(defaultNamespace)
+			d.AddLabel(apis.AppPartOfLabelName, "petclinic")
+		}).
+		SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+			d.Source(&cartov1alpha1.Source{
+				Git: &cartov1alpha1.GitSource{
+					URL: "https://github.com/spring-projects/spring-petclinic.git",
+					Ref: cartov1alpha1.GitRef{
+						Branch: "main",
+					},
+				},
+			})
+		})
+	ui := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("petclinic-ui")
+			d.Namespace(defaultNamespace)
+			d.AddLabel(apis.AppPartOfLabelName, "petclinic")
+		}).
+		SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+			d.Image("registry.example/petclinic-ui:latest")
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "missing file",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:        "unsupported flag",
+			Args:        []string{flags.FilePathFlagName, file, flags.YesFlagName},
+			ShouldError: true,
+		},
+		{
+			Name:         "no drift",
+			Args:         []string{flags.FilePathFlagName, file},
+			GivenObjects: []client.Object{namespace, api, ui},
+			ExpectOutput: `
+Workload "petclinic-api" is unchanged
+Workload "petclinic-ui" is unchanged
+`,
+		},
+		{
+			Name: "drift",
+			Args: []string{flags.FilePathFlagName, file},
+			GivenObjects: []client.Object{
+				namespace,
+				ui.SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+					d.Image("registry.example/petclinic-ui:v1")
+				}),
+			},
+			ExpectOutput: `
+🔎 Workload "petclinic-api" does not exist on the cluster:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic-api
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  source:
+     11 + |    git:
+     12 + |      ref:
+     13 + |        branch: main
+     14 + |      url: https://github.com/spring-projects/spring-petclinic.git
+🔎 Workload "petclinic-ui" differs from the cluster:
+...
+  6,  6   |    app.kubernetes.io/part-of: petclinic
+  7,  7   |  name: petclinic-ui
+  8,  8   |  namespace: default
+  9,  9   |spec:
+ 10     - |  image: registry.example/petclinic-ui:v1
+     10 + |  image: registry.example/petclinic-ui:latest
+`,
+			ShouldError: true,
+		},
+		{
+			Name:         "drift from flags",
+			Args:         []string{flags.FilePathFlagName, file, flags.EnvFlagName, "FOO=bar"},
+			GivenObjects: []client.Object{namespace, api, ui},
+			ExpectOutput: `
+🔎 Workload "petclinic-api" differs from the cluster:
+...
+  6,  6   |    app.kubernetes.io/part-of: petclinic
+  7,  7   |  name: petclinic-api
+  8,  8   |  namespace: default
+  9,  9   |spec:
+     10 + |  env:
+     11 + |  - name: FOO
+     12 + |    value: bar
+ 10, 13   |  source:
+ 11, 14   |    git:
+ 12, 15   |      ref:
+ 13, 16   |        branch: main
+...
+🔎 Workload "petclinic-ui" differs from the cluster:
+...
+  6,  6   |    app.kubernetes.io/part-of: petclinic
+  7,  7   |  name: petclinic-ui
+  8,  8   |  namespace: default
+  9,  9   |spec:
+     10 + |  env:
+     11 + |  - name: FOO
+     12 + |    value: bar
+ 10, 13   |  image: registry.example/petclinic-ui:latest
+`,
+			ShouldError: true,
+		},
+		{
+			Name:         "workload name",
+			Args:         []string{"petclinic", flags.FilePathFlagName, "testdata/workloads-dir/ui.yml"},
+			GivenObjects: []client.Object{namespace, ui},
+			ExpectOutput: `
+🔎 Workload "petclinic" does not exist on the cluster:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: petclinic
+      7 + |  name: petclinic
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  image: registry.example/petclinic-ui:latest
+`,
+			ShouldError: true,
+		},
+		{
+			Name: "merge update strategy",
+			Args: []string{flags.FilePathFlagName, file},
+			GivenObjects: []client.Object{
+				namespace,
+				api,
+				ui.SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+					d.EnvDie("FOO", func(d *diecorev1.EnvVarDie) {
+						d.Value("bar")
+					})
+				}),
+			},
+			ExpectOutput: `
+Workload "petclinic-api" is unchanged
+Workload "petclinic-ui" is unchanged
+`,
+		},
+		{
+			Name: "replace update strategy",
+			Args: []string{flags.FilePathFlagName, file, flags.UpdateStrategyFlagName, "replace"},
+			GivenObjects: []client.Object{
+				namespace,
+				api,
+				ui.SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+					d.EnvDie("FOO", func(d *diecorev1.EnvVarDie) {
+						d.Value("bar")
+					})
+				}),
+			},
+			ExpectOutput: `
+Workload "petclinic-api" is unchanged
+🔎 Workload "petclinic-ui" differs from the cluster:
+...
+  6,  6   |    app.kubernetes.io/part-of: petclinic
+  7,  7   |  name: petclinic-ui
+  8,  8   |  namespace: default
+  9,  9   |spec:
+ 10     - |  env:
+ 11     - |  - name: FOO
+ 12     - |    value: bar
+ 13, 10   |  image: registry.example/petclinic-ui:latest
+`,
+			ShouldError: true,
+		},
+		{
+			Name:         "name argument with multiple workloads",
+			Args:         []string{"petclinic", flags.FilePathFlagName, file},
+			GivenObjects: []client.Object{namespace, api, ui},
+			ShouldError:  true,
+		},
+		{
+			Name:         "get failed",
+			Args:         []string{flags.FilePathFlagName, file},
+			GivenObjects: []client.Object{namespace, api, ui},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Workload"),
+			},
+			ExpectOutput: `
+
+Error: workload "petclinic-api": inducing failure for get Workload
+Error: workload "petclinic-ui": inducing failure for get Workload
+`,
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadDiffCommand)
+}
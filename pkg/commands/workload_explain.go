@@ -0,0 +1,293 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/logs"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+// workloadExplainLogLines is the number of lines printed from the end of the logs of each container
+// of the pods related to the failure.
+const workloadExplainLogLines int64 = 50
+
+// runnableLabelName is the label Cartographer sets on the objects a runnable stamps.
+const runnableLabelName = "carto.run/runnable-name"
+
+var (
+	kpackImageGVK  = schema.GroupVersionKind{Group: "kpack.io", Version: "v1alpha2", Kind: "Image"}
+	kpackBuildGVK  = schema.GroupVersionKind{Group: "kpack.io", Version: "v1alpha2", Kind: "Build"}
+	runnableGVK    = schema.GroupVersionKind{Group: "carto.run", Version: "v1alpha1", Kind: "Runnable"}
+	taskRunListGVK = schema.GroupVersionKind{Group: "tekton.dev", Version: "v1beta1", Kind: "TaskRunList"}
+	podIntentGVK   = schema.GroupVersionKind{Group: "conventions.carto.run", Version: "v1alpha1", Kind: "PodIntent"}
+)
+
+type WorkloadExplainOptions struct {
+	Namespace string
+	Name      string
+}
+
+var (
+	_ validation.Validatable = (*WorkloadExplainOptions)(nil)
+	_ cli.Executable         = (*WorkloadExplainOptions)(nil)
+)
+
+func (opts *WorkloadExplainOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	} else {
+		errs = errs.Also(validation.K8sName(opts.Name, cli.NameArgumentName))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadExplainOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workload := &cartov1alpha1.Workload{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: opts.Name}, workload)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			nsGet := &corev1.Namespace{}
+			if getErr := c.Get(ctx, types.NamespacedName{Name: opts.Namespace}, nsGet); getErr != nil && apierrs.IsNotFound(getErr) {
+				c.Eprintf("%s %s\n", printer.Serrorf("Error:"), fmt.Sprintf("namespace %q not found, it may not exist or user does not have permissions to read it.", opts.Namespace))
+				return cli.SilenceError(getErr)
+			}
+			c.Errorf("Workload %q not found\n", fmt.Sprintf("%s/%s", opts.Namespace, opts.Name))
+			return cli.SilenceError(err)
+		}
+		return err
+	}
+
+	resource := firstNotReadyResource(workload)
+	if resource == nil {
+		readyCond := printer.FindCondition(workload.Status.Conditions, cartov1alpha1.WorkloadConditionReady)
+		if readyCond != nil && readyCond.Status == metav1.ConditionTrue {
+			c.Successf("Workload %q is ready\n", workload.Name)
+			return nil
+		}
+		c.Infof("No failing resource found for workload %q\n", workload.Name)
+		if readyCond != nil && strings.TrimSpace(readyCond.Message) != "" {
+			c.Printf("\n")
+			c.Emoji(cli.SpeechBalloon, cliprinter.Sboldf("Messages\n"))
+			return printer.WorkloadIssuesPrinter(c.Stdout, workload)
+		}
+		return nil
+	}
+
+	objects := []printer.ExplainedObject{{
+		Kind:      "Resource",
+		Name:      resource.Name,
+		Condition: printer.FindCondition(resource.Conditions, cartov1alpha1.ConditionResourceReady),
+	}}
+	pods := []types.NamespacedName{}
+	if ref := resource.StampedRef; ref != nil && ref.ObjectReference != nil {
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = workload.Namespace
+		}
+		stamped := &unstructured.Unstructured{}
+		stamped.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+		explained, err := explainObject(ctx, c, stamped, namespace, ref.Name)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, explained)
+		if explained.Message == "" {
+			children, childPods, err := explainStampedChildren(ctx, c, stamped)
+			if err != nil {
+				return err
+			}
+			objects = append(objects, children...)
+			pods = append(pods, childPods...)
+		}
+	}
+
+	c.Emoji(cli.Magnifying, cliprinter.Sboldf("Resource %q of workload %q is not ready\n", resource.Name, workload.Name))
+	if err := printer.WorkloadExplainPrinter(c.Stdout, workload, objects); err != nil {
+		return err
+	}
+
+	for _, pod := range pods {
+		c.Printf("\n")
+		c.Emoji(cli.Canoe, cliprinter.Sboldf("Logs of pod %q\n", pod.Name))
+		if err := logs.Logs(ctx, c, pod.Namespace, pod.Name, []string{}, workloadExplainLogLines); err != nil {
+			c.Emoji(cli.Exclamation, cliprinter.Sinfof("WARNING: unable to get logs of pod %q: %s\n", pod.Name, err))
+		}
+	}
+	return nil
+}
+
+// firstNotReadyResource returns the first resource of the workload, in the order of the supply
+// chain, that is not ready.
+func firstNotReadyResource(workload *cartov1alpha1.Workload) *cartov1alpha1.RealizedResource {
+	for i := range workload.Status.Resources {
+		resource := &workload.Status.Resources[i]
+		if cond := printer.FindCondition(resource.Conditions, cartov1alpha1.ConditionResourceReady); cond == nil || cond.Status != metav1.ConditionTrue {
+			return resource
+		}
+	}
+	return nil
+}
+
+// explainObject reads the object into obj and returns its state, the object is reported as not
+// found rather than failing the whole explanation.
+func explainObject(ctx context.Context, c *cli.Config, obj *unstructured.Unstructured, namespace, name string) (printer.ExplainedObject, error) {
+	explained := printer.ExplainedObject{Kind: obj.GetKind(), Name: name}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return explained, err
+		}
+		explained.Message = "not found"
+		return explained, nil
+	}
+	explained.Condition = unstructuredCondition(obj)
+	return explained, nil
+}
+
+// explainStampedChildren drills into the well-known children of the stamped object, the build of a
+// kpack image and the task run of a runnable, returning their state and the pods they ran, which
+// are in the namespace of the child that ran them.
+func explainStampedChildren(ctx context.Context, c *cli.Config, stamped *unstructured.Unstructured) ([]printer.ExplainedObject, []types.NamespacedName, error) {
+	objects := []printer.ExplainedObject{}
+	pods := []types.NamespacedName{}
+	addPod := func(obj *unstructured.Unstructured) {
+		if pod, _, _ := unstructured.NestedString(obj.Object, "status", "podName"); pod != "" {
+			pods = append(pods, types.NamespacedName{Namespace: obj.GetNamespace(), Name: pod})
+		}
+	}
+
+	switch stamped.GroupVersionKind().GroupKind() {
+	case kpackImageGVK.GroupKind():
+		buildName, _, _ := unstructured.NestedString(stamped.Object, "status", "latestBuildRef")
+		if buildName == "" {
+			break
+		}
+		build := &unstructured.Unstructured{}
+		build.SetGroupVersionKind(kpackBuildGVK)
+		explained, err := explainObject(ctx, c, build, stamped.GetNamespace(), buildName)
+		if err != nil {
+			return nil, nil, err
+		}
+		objects = append(objects, explained)
+		if explained.Message == "" {
+			addPod(build)
+		}
+	case runnableGVK.GroupKind():
+		taskRuns := &unstructured.UnstructuredList{}
+		taskRuns.SetGroupVersionKind(taskRunListGVK)
+		if err := c.List(ctx, taskRuns, client.InNamespace(stamped.GetNamespace()), client.MatchingLabels{runnableLabelName: stamped.GetName()}); err != nil {
+			// tekton is optional on the cluster, there is nothing to drill into when task runs
+			// cannot be listed
+			break
+		}
+		if len(taskRuns.Items) == 0 {
+			break
+		}
+		sort.Slice(taskRuns.Items, func(i, j int) bool {
+			return taskRuns.Items[i].GetCreationTimestamp().Time.After(taskRuns.Items[j].GetCreationTimestamp().Time)
+		})
+		taskRun := &taskRuns.Items[0]
+		objects = append(objects, printer.ExplainedObject{
+			Kind:      taskRun.GetKind(),
+			Name:      taskRun.GetName(),
+			Condition: unstructuredCondition(taskRun),
+		})
+		addPod(taskRun)
+	case podIntentGVK.GroupKind():
+		// conventions are applied by the controller itself, the condition of the pod intent
+		// already holds the failure
+	}
+	return objects, pods, nil
+}
+
+// unstructuredCondition returns the condition that tells the state of the object, "Ready" for most
+// objects or "Succeeded" for the ones that run to completion like builds and task runs.
+func unstructuredCondition(obj *unstructured.Unstructured) *metav1.Condition {
+	items, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	conditions := []metav1.Condition{}
+	for _, item := range items {
+		u, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		cond := metav1.Condition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, &cond); err != nil {
+			continue
+		}
+		conditions = append(conditions, cond)
+	}
+	for _, conditionType := range []string{cartov1alpha1.ConditionReady, "Succeeded"} {
+		if cond := printer.FindCondition(conditions, conditionType); cond != nil {
+			return cond
+		}
+	}
+	return nil
+}
+
+func NewWorkloadExplainCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadExplainOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "explain",
+		Short: "Explain why a workload is not ready",
+		Long: strings.TrimSpace(`
+Explain why a workload is not ready. The first resource of the workload that is not
+ready is walked down to the object reporting the failure, the build of a kpack image,
+the task run of a runnable or the pod intent of the conventions, printing the
+condition of each object and the last lines of the logs of the related pods.
+`),
+		Example:           fmt.Sprintf("%s workload explain my-workload", c.Name),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+
+	return cmd
+}
@@ -0,0 +1,415 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/logs"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestWorkloadExplainOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "invalid empty",
+			Validatable: &commands.WorkloadExplainOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.WorkloadExplainOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid name",
+			Validatable: &commands.WorkloadExplainOptions{
+				Namespace: "default",
+				Name:      "my-",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("my-", cli.NameArgumentName),
+		},
+	}
+	table.Run(t)
+}
+
+func TestWorkloadExplainCommand(t *testing.T) {
+	workloadName := "my-workload"
+	defaultNamespace := "default"
+	testsNamespace := "tests"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	parent := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+		})
+	sourceProvider := diecartov1alpha1.RealizedResourceBlank.
+		Name("source-provider").
+		ConditionsDie(
+			diecartov1alpha1.WorkloadConditionResourceReadyBlank.
+				Status(metav1.ConditionTrue),
+		).DieRelease()
+	stampedResource := func(name, apiVersion, kind, stampedName string) cartov1alpha1.RealizedResource {
+		return diecartov1alpha1.RealizedResourceBlank.
+			Name(name).
+			StampedRef(&cartov1alpha1.StampedRef{
+				ObjectReference: &corev1.ObjectReference{
+					APIVersion: apiVersion,
+					Kind:       kind,
+					Namespace:  defaultNamespace,
+					Name:       stampedName,
+				},
+			}).
+			ConditionsDie(
+				diecartov1alpha1.WorkloadConditionResourceReadyBlank.
+					Status(metav1.ConditionFalse).Reason("HealthyConditionRule").
+					Message("condition status: False, message: build failed"),
+			).DieRelease()
+	}
+	unstructuredObject := func(apiVersion, kind, name string, status map[string]interface{}, labels map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": apiVersion,
+				"kind":       kind,
+				"metadata": map[string]interface{}{
+					"namespace": defaultNamespace,
+					"name":      name,
+					"labels":    labels,
+				},
+				"status": status,
+			},
+		}
+	}
+	failedCondition := func(conditionType, reason, message string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"type":               conditionType,
+				"status":             "False",
+				"reason":             reason,
+				"message":            message,
+				"lastTransitionTime": "2019-06-29T01:44:05Z",
+			},
+		}
+	}
+	image := unstructuredObject("kpack.io/v1alpha2", "Image", workloadName, map[string]interface{}{
+		"latestBuildRef": "my-workload-build-1",
+		"conditions":     failedCondition("Ready", "", "Build failed"),
+	}, nil)
+	build := unstructuredObject("kpack.io/v1alpha2", "Build", "my-workload-build-1", map[string]interface{}{
+		"podName":    "my-workload-build-1-build-pod",
+		"conditions": failedCondition("Succeeded", "", "Build pod failed on step build"),
+	}, nil)
+	runnable := unstructuredObject("carto.run/v1alpha1", "Runnable", workloadName, map[string]interface{}{
+		"conditions": failedCondition("Ready", "OutputNotSatisfied", "tests failed"),
+	}, nil)
+	taskRun := unstructuredObject("tekton.dev/v1beta1", "TaskRun", "my-workload-abcde", map[string]interface{}{
+		"podName":    "my-workload-abcde-pod",
+		"conditions": failedCondition("Succeeded", "Failed", "\"step-test\" exited with code 1"),
+	}, map[string]interface{}{"carto.run/runnable-name": workloadName})
+	podIntent := unstructuredObject("conventions.carto.run/v1alpha1", "PodIntent", workloadName, map[string]interface{}{
+		"conditions": failedCondition("Ready", "ConventionsApplied", "fetching metadata for images failed"),
+	}, nil)
+	inNamespace := func(obj *unstructured.Unstructured, namespace string) *unstructured.Unstructured {
+		obj = obj.DeepCopy()
+		obj.SetNamespace(namespace)
+		return obj
+	}
+	withTailer := func(namespace, pod string, err error) func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+		return func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+			tailer := &logs.FakeTailer{}
+			tailer.On("Logs", mock.Anything, namespace, pod, []string{}, int64(50)).Return(err).Once()
+			return logs.StashTailer(ctx, tailer), nil
+		}
+	}
+	assertTailer := func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) error {
+		tailer := logs.RetrieveTailer(ctx).(*logs.FakeTailer)
+		tailer.AssertExpectations(t)
+		return nil
+	}
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "empty",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name: "missing workload",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+		},
+		{
+			Name:        "missing namespace",
+			Args:        []string{workloadName},
+			ShouldError: true,
+			ExpectOutput: `
+Error: namespace "default" not found, it may not exist or user does not have permissions to read it.
+`,
+		},
+		{
+			Name:        "failed to get workload",
+			Args:        []string{workloadName},
+			ShouldError: true,
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Workload"),
+			},
+		},
+		{
+			Name: "ready workload",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionTrue),
+						)
+						d.Resources(sourceProvider)
+					}),
+			},
+			ExpectOutput: `
+Workload "my-workload" is ready
+`,
+		},
+		{
+			Name: "no failing resource",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionFalse).Reason("SupplyChainNotFound").
+								Message("no supply chain found where full selector is satisfied by labels"),
+						)
+					}),
+			},
+			ExpectOutput: `
+No failing resource found for workload "my-workload"
+
+💬 Messages
+   Workload [SupplyChainNotFound]:   no supply chain found where full selector is satisfied by labels
+`,
+		},
+		{
+			Name: "failing kpack image",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(sourceProvider, stampedResource("image-provider", "kpack.io/v1alpha2", "Image", workloadName))
+					}),
+				image,
+				build,
+			},
+			Prepare: withTailer(defaultNamespace, "my-workload-build-1-build-pod", nil),
+			CleanUp: assertTailer,
+			ExpectOutput: `
+🔎 Resource "image-provider" of workload "my-workload" is not ready
+   OBJECT                      CONDITION   STATUS   REASON                 MESSAGE
+   Resource/image-provider     Ready       False    HealthyConditionRule   condition status: False, message: build failed
+   Image/my-workload           Ready       False    <empty>                Build failed
+   Build/my-workload-build-1   Succeeded   False    <empty>                Build pod failed on step build
+
+🛶 Logs of pod "my-workload-build-1-build-pod"
+...log output...
+`,
+		},
+		{
+			Name: "failing kpack image logs error",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(sourceProvider, stampedResource("image-provider", "kpack.io/v1alpha2", "Image", workloadName))
+					}),
+				image,
+				build,
+			},
+			Prepare: withTailer(defaultNamespace, "my-workload-build-1-build-pod", fmt.Errorf("pod not found")),
+			CleanUp: assertTailer,
+			ExpectOutput: `
+🔎 Resource "image-provider" of workload "my-workload" is not ready
+   OBJECT                      CONDITION   STATUS   REASON                 MESSAGE
+   Resource/image-provider     Ready       False    HealthyConditionRule   condition status: False, message: build failed
+   Image/my-workload           Ready       False    <empty>                Build failed
+   Build/my-workload-build-1   Succeeded   False    <empty>                Build pod failed on step build
+
+🛶 Logs of pod "my-workload-build-1-build-pod"
+...log output...
+❗ WARNING: unable to get logs of pod "my-workload-build-1-build-pod": pod not found
+`,
+		},
+		{
+			Name: "failing kpack image without build",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(sourceProvider, stampedResource("image-provider", "kpack.io/v1alpha2", "Image", workloadName))
+					}),
+				image,
+			},
+			ExpectOutput: `
+🔎 Resource "image-provider" of workload "my-workload" is not ready
+   OBJECT                      CONDITION   STATUS    REASON                 MESSAGE
+   Resource/image-provider     Ready       False     HealthyConditionRule   condition status: False, message: build failed
+   Image/my-workload           Ready       False     <empty>                Build failed
+   Build/my-workload-build-1   <empty>     <empty>   <empty>                not found
+`,
+		},
+		{
+			Name: "failing runnable",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(sourceProvider, stampedResource("source-tester", "carto.run/v1alpha1", "Runnable", workloadName))
+					}),
+				runnable,
+				taskRun,
+			},
+			Prepare: withTailer(defaultNamespace, "my-workload-abcde-pod", nil),
+			CleanUp: assertTailer,
+			ExpectOutput: `
+🔎 Resource "source-tester" of workload "my-workload" is not ready
+   OBJECT                      CONDITION   STATUS   REASON                 MESSAGE
+   Resource/source-tester      Ready       False    HealthyConditionRule   condition status: False, message: build failed
+   Runnable/my-workload        Ready       False    OutputNotSatisfied     tests failed
+   TaskRun/my-workload-abcde   Succeeded   False    Failed                 "step-test" exited with code 1
+
+🛶 Logs of pod "my-workload-abcde-pod"
+...log output...
+`,
+		},
+		{
+			Name: "failing runnable in another namespace",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						resource := stampedResource("source-tester", "carto.run/v1alpha1", "Runnable", workloadName)
+						resource.StampedRef.Namespace = testsNamespace
+						d.Resources(sourceProvider, resource)
+					}),
+				inNamespace(runnable, testsNamespace),
+				inNamespace(taskRun, testsNamespace),
+			},
+			Prepare: withTailer(testsNamespace, "my-workload-abcde-pod", nil),
+			CleanUp: assertTailer,
+			ExpectOutput: `
+🔎 Resource "source-tester" of workload "my-workload" is not ready
+   OBJECT                      CONDITION   STATUS   REASON                 MESSAGE
+   Resource/source-tester      Ready       False    HealthyConditionRule   condition status: False, message: build failed
+   Runnable/my-workload        Ready       False    OutputNotSatisfied     tests failed
+   TaskRun/my-workload-abcde   Succeeded   False    Failed                 "step-test" exited with code 1
+
+🛶 Logs of pod "my-workload-abcde-pod"
+...log output...
+`,
+		},
+		{
+			Name: "failing pod intent",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(sourceProvider, stampedResource("config-provider", "conventions.carto.run/v1alpha1", "PodIntent", workloadName))
+					}),
+				podIntent,
+			},
+			ExpectOutput: `
+🔎 Resource "config-provider" of workload "my-workload" is not ready
+   OBJECT                     CONDITION   STATUS   REASON                 MESSAGE
+   Resource/config-provider   Ready       False    HealthyConditionRule   condition status: False, message: build failed
+   PodIntent/my-workload      Ready       False    ConventionsApplied     fetching metadata for images failed
+`,
+		},
+		{
+			Name: "failing resource without stamped object",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(sourceProvider, stampedResource("config-provider", "conventions.carto.run/v1alpha1", "PodIntent", workloadName))
+					}),
+			},
+			ExpectOutput: `
+🔎 Resource "config-provider" of workload "my-workload" is not ready
+   OBJECT                     CONDITION   STATUS    REASON                 MESSAGE
+   Resource/config-provider   Ready       False     HealthyConditionRule   condition status: False, message: build failed
+   PodIntent/my-workload      <empty>     <empty>   <empty>                not found
+`,
+		},
+		{
+			Name: "failed to get stamped object",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(sourceProvider, stampedResource("config-provider", "conventions.carto.run/v1alpha1", "PodIntent", workloadName))
+					}),
+				podIntent,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "PodIntent"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, func(ctx context.Context, c *cli.Config) *cobra.Command {
+		return commands.NewWorkloadExplainCommand(ctx, c)
+	})
+}
@@ -19,20 +19,27 @@ package commands
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	k8swatch "k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
 	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
 	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
@@ -43,8 +50,12 @@ type WorkloadGetOptions struct {
 	Namespace string
 	Name      string
 
-	Export bool
-	Output string
+	Export      bool
+	Graph       string
+	Output      string
+	OutputOf    string
+	Watch       bool
+	EventsLimit int
 }
 
 var (
@@ -64,7 +75,50 @@ func (opts *WorkloadGetOptions) Validate(ctx context.Context) validation.FieldEr
 	}
 
 	if opts.Output != "" {
-		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml, WorkloadReportOutputFormatJson, WorkloadReportOutputFormatYaml}))
+	}
+	if opts.Export && (opts.Output == WorkloadReportOutputFormatJson || opts.Output == WorkloadReportOutputFormatYaml) {
+		errs = errs.Also(validation.ErrMultipleOneOf(flags.ExportFlagName, flags.OutputFlagName))
+	}
+
+	if opts.Watch && opts.Output != "" {
+		errs = errs.Also(validation.ErrMultipleOneOf(flags.WatchFlagName, flags.OutputFlagName))
+	}
+	if opts.Watch && opts.Export {
+		errs = errs.Also(validation.ErrMultipleOneOf(flags.WatchFlagName, flags.ExportFlagName))
+	}
+
+	if opts.OutputOf != "" {
+		errs = errs.Also(validation.Enum(opts.OutputOf, flags.OutputOfFlagName, []string{cartov1alpha1.OutputImage, cartov1alpha1.OutputURL, cartov1alpha1.OutputRevision, cartov1alpha1.OutputConfig}))
+		if opts.Output != "" {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.OutputOfFlagName, flags.OutputFlagName))
+		}
+		if opts.Export {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.OutputOfFlagName, flags.ExportFlagName))
+		}
+		if opts.Watch {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.OutputOfFlagName, flags.WatchFlagName))
+		}
+		if opts.Graph != "" {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.OutputOfFlagName, flags.GraphFlagName))
+		}
+	}
+
+	if opts.EventsLimit < 0 {
+		errs = errs.Also(validation.ErrInvalidValue(opts.EventsLimit, flags.EventsLimitFlagName))
+	}
+
+	if opts.Graph != "" {
+		errs = errs.Also(validation.Enum(opts.Graph, flags.GraphFlagName, []string{printer.GraphFormatASCII, printer.GraphFormatDot, printer.GraphFormatMermaid}))
+		if opts.Output != "" {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.GraphFlagName, flags.OutputFlagName))
+		}
+		if opts.Export {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.GraphFlagName, flags.ExportFlagName))
+		}
+		if opts.Watch {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.GraphFlagName, flags.WatchFlagName))
+		}
 	}
 
 	return errs
@@ -87,6 +141,18 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 		return err
 	}
 
+	if opts.OutputOf != "" {
+		return opts.printOutputOf(ctx, c, workload)
+	}
+
+	if opts.Graph != "" {
+		if len(workload.Status.Resources) == 0 {
+			c.Infof("Supply Chain resources not found.\n")
+			return nil
+		}
+		return printer.GraphPrinter(c.Stdout, opts.Graph, workload.Name, printer.WorkloadGraph(workload))
+	}
+
 	if opts.Export {
 		var format printer.OutputFormat
 		if opts.Output == "" {
@@ -95,7 +161,7 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 			format = printer.OutputFormat(opts.Output)
 		}
 
-		export, err := printer.ExportResource(workload, format, c.Scheme)
+		export, err := printer.ExportResource(withoutHistory(workload), format, c.Scheme)
 		if err != nil {
 			c.Eprintf("%s %s\n", printer.Serrorf("Failed to export workload:"), err)
 			return cli.SilenceError(err)
@@ -104,6 +170,25 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 		return nil
 	}
 
+	if opts.Output == WorkloadReportOutputFormatJson || opts.Output == WorkloadReportOutputFormatYaml {
+		report, err := NewWorkloadReport(ctx, c, workload)
+		if err != nil {
+			return err
+		}
+		format := printer.OutputFormat(printer.OutputFormatJson)
+		if opts.Output == WorkloadReportOutputFormatYaml {
+			format = printer.OutputFormat(printer.OutputFormatYaml)
+		}
+		export, err := printer.OutputObject(report, format)
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output workload report:"), err)
+			return cli.SilenceError(err)
+		}
+
+		c.Printf("%s\n", export)
+		return nil
+	}
+
 	if opts.Output != "" {
 		export, err := printer.OutputResource(workload, printer.OutputFormat(opts.Output), c.Scheme)
 		if err != nil {
@@ -115,10 +200,143 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 		return nil
 	}
 
+	deliverableResourceVersion, err := opts.printStatus(ctx, c, workload)
+	if err != nil {
+		return err
+	}
+
+	if len(workload.Spec.ServiceClaims) > 0 {
+		c.Printf("\n")
+		c.Emoji(cli.Repeat, cliprinter.Sboldf("Services\n"))
+		if err := cartov1alpha1.WorkloadServiceClaimPrinter(c.Stdout, workload); err != nil {
+			return err
+		}
+	}
+
+	arg := []string{"Pod"}
+	labelSelectorParams := fmt.Sprintf("%s%s%s", cartov1alpha1.WorkloadLabelName, "=", workload.Name)
+	if tableResult, err := source.FetchResourceObjects(c.Builder, workload.Namespace, labelSelectorParams, arg); err != nil {
+		c.Eprintf("\n")
+		c.Eerrorf("Failed to list pods:\n")
+		c.Eprintf("  %s\n", err)
+	} else {
+		if tableResult != nil {
+			c.Printf("\n")
+			c.Emoji(cli.Canoe, cliprinter.Sboldf("Pods\n"))
+			printer.PodTablePrinter(c, tableResult)
+		} else {
+			c.Printf("\n")
+			c.Infof("No pods found for workload.\n")
+		}
+	}
+
+	ksvcs := &knativeservingv1.ServiceList{}
+	_ = c.List(ctx, ksvcs, client.InNamespace(workload.Namespace), client.MatchingLabels{cartov1alpha1.WorkloadLabelName: workload.Name})
+	if len(ksvcs.Items) > 0 {
+		ksvcs = ksvcs.DeepCopy()
+		printer.SortByNamespaceAndName(ksvcs.Items)
+		c.Printf("\n")
+		c.Emoji(cli.Ship, cliprinter.Sboldf("Knative Services\n"))
+		if err := printer.KnativeServicePrinter(c, ksvcs); err != nil {
+			return err
+		}
+	}
+
+	if opts.EventsLimit > 0 {
+		if events, err := workloadEvents(ctx, c, workload, opts.EventsLimit); err != nil {
+			c.Eprintf("\n")
+			c.Eerrorf("Failed to list events:\n")
+			c.Eprintf("  %s\n", err)
+		} else if len(events.Items) > 0 {
+			c.Printf("\n")
+			c.Emoji(cli.Bell, cliprinter.Sboldf("Events\n"))
+			if err := printer.EventsPrinter(c.Stdout, events); err != nil {
+				return err
+			}
+		}
+	}
+
+	c.Printf("\n")
+	if workload.Namespace != c.Client.DefaultNamespace() {
+		c.Infof("To see logs: \"tanzu apps workload tail %s %s %s %s %s 1h\"\n", workload.Name, flags.NamespaceFlagName, workload.Namespace, flags.TimestampFlagName, flags.SinceFlagName)
+	} else {
+		c.Infof("To see logs: \"tanzu apps workload tail %s %s %s 1h\"\n", workload.Name, flags.TimestampFlagName, flags.SinceFlagName)
+	}
+	c.Printf("\n")
+
+	if opts.Watch {
+		return opts.watch(ctx, c, workload, deliverableResourceVersion)
+	}
+	return nil
+}
+
+// printOutputOf prints the full value of an output of the workload resources, read from the object
+// stamped for the resource at the path its template declares for the output.
+func (opts *WorkloadGetOptions) printOutputOf(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) error {
+	fail := func(err error) error {
+		c.Eprintf("%s %s\n", printer.Serrorf("Error:"), err)
+		return cli.SilenceError(err)
+	}
+
+	resource := workload.GetResourceWithOutput(opts.OutputOf)
+	if resource == nil {
+		return fail(fmt.Errorf("no resource of workload %q produces the %s output", workload.Name, opts.OutputOf))
+	}
+	if resource.TemplateRef == nil || resource.StampedRef == nil || resource.StampedRef.ObjectReference == nil {
+		return fail(fmt.Errorf("resource %q of workload %q does not reference its template and stamped object", resource.Name, workload.Name))
+	}
+
+	template := &unstructured.Unstructured{}
+	template.SetGroupVersionKind(schema.FromAPIVersionAndKind(resource.TemplateRef.APIVersion, resource.TemplateRef.Kind))
+	if err := c.Get(ctx, client.ObjectKey{Name: resource.TemplateRef.Name}, template); err != nil {
+		if apierrs.IsNotFound(err) {
+			return fail(fmt.Errorf("template %s %q of resource %q not found", resource.TemplateRef.Kind, resource.TemplateRef.Name, resource.Name))
+		}
+		return err
+	}
+	path, err := cartov1alpha1.GetOutputPath(template, opts.OutputOf)
+	if err != nil {
+		return fail(err)
+	}
+
+	ref := resource.StampedRef
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = workload.Namespace
+	}
+	stamped := &unstructured.Unstructured{}
+	stamped.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, stamped); err != nil {
+		if apierrs.IsNotFound(err) {
+			return fail(fmt.Errorf("%s %q of resource %q not found", ref.Kind, ref.Name, resource.Name))
+		}
+		return err
+	}
+	value, err := cartov1alpha1.GetOutputValue(stamped, path)
+	if err != nil {
+		return fail(err)
+	}
+
+	if s, ok := value.(string); ok {
+		c.Printf("%s\n", s)
+		return nil
+	}
+	out, err := yaml.Marshal(value)
+	if err != nil {
+		return err
+	}
+	c.Printf("%s", out)
+	return nil
+}
+
+// printStatus prints the sections of the workload details that follow the progress of the supply
+// chain, from the overview to the messages. It returns the resource version of the deliverable
+// printed, empty when the workload has no deliverable.
+func (opts *WorkloadGetOptions) printStatus(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) (string, error) {
 	//print workload details
 	c.Emoji(cli.Antenna, cliprinter.Sboldf("Overview\n"))
 	if err := printer.WorkloadOverviewPrinter(c.Stdout, workload); err != nil {
-		return err
+		return "", err
 	}
 	c.Printf("\n")
 	// Print workload source
@@ -127,19 +345,19 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 
 		if workload.Spec.Image != "" {
 			if err := printer.WorkloadSourceImagePrinter(c.Stdout, workload); err != nil {
-				return err
+				return "", err
 			}
 		}
 
 		if workload.Spec.Source != nil {
 			if workload.Spec.Source.Image != "" {
 				if err := printer.WorkloadLocalSourceImagePrinter(c.Stdout, workload); err != nil {
-					return err
+					return "", err
 				}
 			}
 			if workload.Spec.Source.Git != nil {
 				if err := printer.WorkloadSourceGitPrinter(c.Stdout, workload); err != nil {
-					return err
+					return "", err
 				}
 			}
 		}
@@ -153,7 +371,7 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 		c.Emoji(cli.Package, cliprinter.Sboldf("Supply Chain\n"))
 
 		if err := printer.WorkloadSupplyChainInfoPrinter(c.Stdout, workload); err != nil {
-			return err
+			return "", err
 		}
 	}
 
@@ -163,7 +381,7 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 		c.Infof(printer.AddPaddingStart("Supply Chain resources not found.\n"))
 	} else {
 		if err := printer.WorkloadResourcesPrinter(c.Stdout, workload); err != nil {
-			return err
+			return "", err
 		}
 	}
 
@@ -182,13 +400,13 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 		} else if deliverable != nil {
 			deliverableStatusReadyCond = printer.FindCondition(deliverable.Status.Conditions, cartov1alpha1.ConditionReady)
 			if err := printer.DeliveryInfoPrinter(c.Stdout, deliverable); err != nil {
-				return err
+				return "", err
 			}
 			c.Printf("\n")
 			if len(deliverable.Status.Resources) == 0 {
 				c.Infof(notFoundMsg)
 			} else if err := printer.DeliverableResourcesPrinter(c.Stdout, deliverable); err != nil {
-				return err
+				return "", err
 			}
 		}
 	} else {
@@ -204,59 +422,82 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 		c.Infof(printer.AddPaddingStart("No messages found.\n"))
 	} else {
 		if err := printer.WorkloadIssuesPrinter(c.Stdout, workload); err != nil {
-			return err
+			return "", err
 		}
 		if err := printer.DeliverableIssuesPrinter(c.Stdout, deliverable); err != nil {
-			return err
+			return "", err
 		}
 	}
 
-	if len(workload.Spec.ServiceClaims) > 0 {
-		c.Printf("\n")
-		c.Emoji(cli.Repeat, cliprinter.Sboldf("Services\n"))
-		if err := cartov1alpha1.WorkloadServiceClaimPrinter(c.Stdout, workload); err != nil {
-			return err
-		}
+	return deliverable.ResourceVersion, nil
+}
+
+// watch prints the status sections again each time the workload or its deliverable change after
+// the resource versions already printed, until the workload is deleted.
+func (opts *WorkloadGetOptions) watch(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload, deliverableResourceVersion string) error {
+	watchClient, err := watch.GetWatcher(ctx, c)
+	if err != nil {
+		return err
+	}
+	workloadWatcher, err := watchClient.Watch(ctx, &cartov1alpha1.WorkloadList{}, &client.ListOptions{
+		Namespace:     opts.Namespace,
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", workload.Name),
+		Raw:           &metav1.ListOptions{ResourceVersion: workload.ResourceVersion},
+	})
+	if err != nil {
+		return err
+	}
+	defer workloadWatcher.Stop()
+	if deliverableResourceVersion == "" {
+		// the deliverable did not exist when the workload was printed
+		deliverableResourceVersion = workload.ResourceVersion
 	}
+	deliverableWatcher, err := watchClient.Watch(ctx, &cartov1alpha1.DeliverableList{}, &client.ListOptions{
+		Namespace: opts.Namespace,
+		Raw:       &metav1.ListOptions{ResourceVersion: deliverableResourceVersion},
+	})
+	if err != nil {
+		return err
+	}
+	defer deliverableWatcher.Stop()
 
-	arg := []string{"Pod"}
-	labelSelectorParams := fmt.Sprintf("%s%s%s", cartov1alpha1.WorkloadLabelName, "=", workload.Name)
-	if tableResult, err := source.FetchResourceObjects(c.Builder, workload.Namespace, labelSelectorParams, arg); err != nil {
-		c.Eprintf("\n")
-		c.Eerrorf("Failed to list pods:\n")
-		c.Eprintf("  %s\n", err)
-	} else {
-		if tableResult != nil {
-			c.Printf("\n")
-			c.Emoji(cli.Canoe, cliprinter.Sboldf("Pods\n"))
-			printer.PodTablePrinter(c, tableResult)
-		} else {
-			c.Printf("\n")
-			c.Infof("No pods found for workload.\n")
+	for {
+		select {
+		case event, ok := <-workloadWatcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			changed, ok := event.Object.(*cartov1alpha1.Workload)
+			if !ok {
+				continue
+			}
+			if event.Type == k8swatch.Deleted {
+				c.Infof("Workload %q was deleted\n", fmt.Sprintf("%s/%s", workload.Namespace, workload.Name))
+				return nil
+			}
+			workload = changed
+		case event, ok := <-deliverableWatcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			changed, ok := event.Object.(*cartov1alpha1.Deliverable)
+			if !ok {
+				continue
+			}
+			ref := getWorkloadResourceByKind(workload, cartov1alpha1.DeliverableKind)
+			if ref == nil || ref.StampedRef.Namespace != changed.Namespace || ref.StampedRef.Name != changed.Name {
+				continue
+			}
+		case <-ctx.Done():
+			return nil
 		}
-	}
 
-	ksvcs := &knativeservingv1.ServiceList{}
-	_ = c.List(ctx, ksvcs, client.InNamespace(workload.Namespace), client.MatchingLabels{cartov1alpha1.WorkloadLabelName: workload.Name})
-	if len(ksvcs.Items) > 0 {
-		ksvcs = ksvcs.DeepCopy()
-		printer.SortByNamespaceAndName(ksvcs.Items)
 		c.Printf("\n")
-		c.Emoji(cli.Ship, cliprinter.Sboldf("Knative Services\n"))
-		if err := printer.KnativeServicePrinter(c, ksvcs); err != nil {
+		if _, err := opts.printStatus(ctx, c, workload); err != nil {
 			return err
 		}
+		c.Printf("\n")
 	}
-
-	c.Printf("\n")
-	if workload.Namespace != c.Client.DefaultNamespace() {
-		c.Infof("To see logs: \"tanzu apps workload tail %s %s %s %s %s 1h\"\n", workload.Name, flags.NamespaceFlagName, workload.Namespace, flags.TimestampFlagName, flags.SinceFlagName)
-	} else {
-		c.Infof("To see logs: \"tanzu apps workload tail %s %s %s 1h\"\n", workload.Name, flags.TimestampFlagName, flags.SinceFlagName)
-	}
-	c.Printf("\n")
-
-	return nil
 }
 
 func NewWorkloadGetCommand(ctx context.Context, c *cli.Config) *cobra.Command {
@@ -268,6 +509,11 @@ func NewWorkloadGetCommand(ctx context.Context, c *cli.Config) *cobra.Command {
 		Long:  strings.TrimSpace(`Get details from a workload`),
 		Example: strings.Join([]string{
 			fmt.Sprintf("%s workload get my-workload", c.Name),
+			fmt.Sprintf("%s workload get my-workload %s", c.Name, flags.WatchFlagName),
+			fmt.Sprintf("%s workload get my-workload %s %s", c.Name, flags.OutputFlagName, WorkloadReportOutputFormatJson),
+			fmt.Sprintf("%s workload get my-workload %s", c.Name, flags.GraphFlagName),
+			fmt.Sprintf("%s workload get my-workload %s=%s", c.Name, flags.GraphFlagName, printer.GraphFormatMermaid),
+			fmt.Sprintf("%s workload get my-workload %s %s", c.Name, flags.OutputOfFlagName, cartov1alpha1.OutputImage),
 		}, "\n"),
 		PreRunE:           cli.ValidateE(ctx, opts),
 		RunE:              cli.ExecE(ctx, c, opts),
@@ -280,11 +526,82 @@ func NewWorkloadGetCommand(ctx context.Context, c *cli.Config) *cobra.Command {
 
 	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
 	cmd.Flags().BoolVar(&opts.Export, cli.StripDash(flags.ExportFlagName), false, "export workload in yaml format")
-	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Workload formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Workload formatted. Supported formats: \"json\", \"yaml\", \"yml\", or \"report-json\" and \"report-yaml\" for all the details of the workload, its deliverable, pods and Knative services")
+	cmd.Flags().StringVar(&opts.Graph, cli.StripDash(flags.GraphFlagName), "", "print the graph of the resources realized for the workload, colored by their Ready condition. Supported formats: \"ascii\" (default), \"dot\", \"mermaid\"")
+	cmd.Flags().Lookup(cli.StripDash(flags.GraphFlagName)).NoOptDefVal = printer.GraphFormatASCII
+	cmd.Flags().BoolVarP(&opts.Watch, cli.StripDash(flags.WatchFlagName), "w", false, "after getting the workload, watch for changes and print its status again each time the workload or its deliverable change")
+	cmd.Flags().StringVar(&opts.OutputOf, cli.StripDash(flags.OutputOfFlagName), "", "print the full value of an output of the supply chain resources, read from the object stamped for the resource that produces it. Supported outputs: \"image\", \"url\", \"revision\", \"config\"")
+	cmd.Flags().IntVar(&opts.EventsLimit, cli.StripDash(flags.EventsLimitFlagName), 10, "maximum `number` of the most recent events of the workload, its stamped resources and its pods to show, 0 to hide the events")
 
 	return cmd
 }
 
+// workloadEvents returns the most recent events about the workload, the resources stamped for it and
+// its pods, sorted by time. Events that repeat the same reason and message for an object are only
+// returned once.
+func workloadEvents(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload, limit int) (*corev1.EventList, error) {
+	involved := map[string]bool{
+		involvedObjectKey(cartov1alpha1.WorkloadKind, workload.Namespace, workload.Name): true,
+	}
+	for _, resource := range workload.Status.Resources {
+		if ref := resource.StampedRef; ref != nil && ref.ObjectReference != nil {
+			namespace := ref.Namespace
+			if namespace == "" {
+				namespace = workload.Namespace
+			}
+			involved[involvedObjectKey(ref.Kind, namespace, ref.Name)] = true
+		}
+	}
+	// the pods section already reports when pods cannot be listed, the events of the other objects
+	// are still worth showing
+	pods := &corev1.PodList{}
+	_ = c.List(ctx, pods, client.InNamespace(workload.Namespace), client.MatchingLabels{cartov1alpha1.WorkloadLabelName: workload.Name})
+	for _, pod := range pods.Items {
+		involved[involvedObjectKey("Pod", pod.Namespace, pod.Name)] = true
+	}
+
+	events := &corev1.EventList{}
+	if err := c.List(ctx, events, client.InNamespace(workload.Namespace)); err != nil {
+		return nil, err
+	}
+	latest := map[string]*corev1.Event{}
+	for i := range events.Items {
+		event := &events.Items[i]
+		ref := event.InvolvedObject
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = event.Namespace
+		}
+		if !involved[involvedObjectKey(ref.Kind, namespace, ref.Name)] {
+			continue
+		}
+		key := strings.Join([]string{ref.Kind, ref.Name, event.Type, event.Reason, event.Message}, "/")
+		if seen, ok := latest[key]; !ok || printer.EventTime(seen).Time.Before(printer.EventTime(event).Time) {
+			latest[key] = event
+		}
+	}
+
+	result := &corev1.EventList{Items: make([]corev1.Event, 0, len(latest))}
+	for _, event := range latest {
+		result.Items = append(result.Items, *event)
+	}
+	sort.SliceStable(result.Items, func(i, j int) bool {
+		ti, tj := printer.EventTime(&result.Items[i]).Time, printer.EventTime(&result.Items[j]).Time
+		if ti.Equal(tj) {
+			return result.Items[i].Name < result.Items[j].Name
+		}
+		return ti.Before(tj)
+	})
+	if len(result.Items) > limit {
+		result.Items = result.Items[len(result.Items)-limit:]
+	}
+	return result, nil
+}
+
+func involvedObjectKey(kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
 func getWorkloadResourceByKind(workload *cartov1alpha1.Workload, kind string) *cartov1alpha1.RealizedResource {
 	for _, resource := range workload.Status.Resources {
 		if resource.StampedRef != nil && resource.StampedRef.Kind == kind {
@@ -17,6 +17,7 @@ limitations under the License.
 package commands_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -25,7 +26,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
@@ -35,6 +38,8 @@ import (
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
 	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	watchhelper "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch"
+	watchfakes "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch/fake"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
 	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
 	diev1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/knative/serving/v1"
@@ -100,7 +105,141 @@ func TestWorkloadGetOptionsValidate(t *testing.T) {
 				Name:      "my-workload",
 				Output:    "myFormat",
 			},
-			ExpectFieldErrors: validation.EnumInvalidValue("myFormat", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+			ExpectFieldErrors: validation.EnumInvalidValue("myFormat", flags.OutputFlagName, []string{"json", "yaml", "yml", "report-json", "report-yaml"}),
+		},
+		{
+			Name: "report output format",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "report-json",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "export report",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Export:    true,
+				Output:    "report-yaml",
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.ExportFlagName, flags.OutputFlagName),
+		},
+		{
+			Name: "watch",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Watch:     true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "watch with output",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "json",
+				Watch:     true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.WatchFlagName, flags.OutputFlagName),
+		},
+		{
+			Name: "watch with export",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Export:    true,
+				Watch:     true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.WatchFlagName, flags.ExportFlagName),
+		},
+		{
+			Name: "graph",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Graph:     "mermaid",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid graph format",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Graph:     "svg",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("svg", flags.GraphFlagName, []string{"ascii", "dot", "mermaid"}),
+		},
+		{
+			Name: "graph with output, export and watch",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Graph:     "dot",
+				Output:    "json",
+				Export:    true,
+				Watch:     true,
+			},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMultipleOneOf(flags.WatchFlagName, flags.OutputFlagName),
+				validation.ErrMultipleOneOf(flags.WatchFlagName, flags.ExportFlagName),
+				validation.ErrMultipleOneOf(flags.GraphFlagName, flags.OutputFlagName),
+				validation.ErrMultipleOneOf(flags.GraphFlagName, flags.ExportFlagName),
+				validation.ErrMultipleOneOf(flags.GraphFlagName, flags.WatchFlagName),
+			),
+		},
+		{
+			Name: "invalid events limit",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace:   "default",
+				Name:        "my-workload",
+				EventsLimit: -1,
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue(-1, flags.EventsLimitFlagName),
+		},
+		{
+			Name: "output of",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				OutputOf:  "image",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output of",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				OutputOf:  "source",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("source", flags.OutputOfFlagName, []string{"image", "url", "revision", "config"}),
+		},
+		{
+			Name: "output of with output, export, watch and graph",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				OutputOf:  "url",
+				Output:    "json",
+				Export:    true,
+				Watch:     true,
+				Graph:     "dot",
+			},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMultipleOneOf(flags.WatchFlagName, flags.OutputFlagName),
+				validation.ErrMultipleOneOf(flags.WatchFlagName, flags.ExportFlagName),
+				validation.ErrMultipleOneOf(flags.OutputOfFlagName, flags.OutputFlagName),
+				validation.ErrMultipleOneOf(flags.OutputOfFlagName, flags.ExportFlagName),
+				validation.ErrMultipleOneOf(flags.OutputOfFlagName, flags.WatchFlagName),
+				validation.ErrMultipleOneOf(flags.OutputOfFlagName, flags.GraphFlagName),
+				validation.ErrMultipleOneOf(flags.GraphFlagName, flags.OutputFlagName),
+				validation.ErrMultipleOneOf(flags.GraphFlagName, flags.ExportFlagName),
+				validation.ErrMultipleOneOf(flags.GraphFlagName, flags.WatchFlagName),
+			),
 		},
 	}
 
@@ -132,6 +271,99 @@ func TestWorkloadGetCommand(t *testing.T) {
 			d.CreationTimestamp(objTimeStamp)
 		}).Kind("pod")
 
+	eventTime := func(years int) metav1.Time {
+		return metav1.NewTime(time.Now().AddDate(-years, 0, 0))
+	}
+	workloadEvent := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-workload.1",
+			Namespace: defaultNamespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      cartov1alpha1.WorkloadKind,
+			Namespace: defaultNamespace,
+			Name:      workloadName,
+		},
+		Type:          corev1.EventTypeWarning,
+		Reason:        "SupplyChainNotFound",
+		Message:       "no supply chain found",
+		LastTimestamp: eventTime(11),
+	}
+	podEvent := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod1.1",
+			Namespace: defaultNamespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Pod",
+			Namespace: defaultNamespace,
+			Name:      "pod1",
+		},
+		Type:          corev1.EventTypeWarning,
+		Reason:        "BackOff",
+		Message:       `Back-off pulling image "my-image"`,
+		LastTimestamp: eventTime(10),
+	}
+	podEventRepeated := podEvent.DeepCopy()
+	podEventRepeated.Name = "pod1.2"
+	podEventRepeated.LastTimestamp = eventTime(12)
+	otherPodEvent := podEvent.DeepCopy()
+	otherPodEvent.Name = "other-pod.1"
+	otherPodEvent.InvolvedObject.Name = "other-pod"
+
+	outputResource := func(name, templateKind, stampedKind, output string) cartov1alpha1.RealizedResource {
+		return diecartov1alpha1.RealizedResourceBlank.
+			Name(name).
+			TemplateRef(&corev1.ObjectReference{
+				APIVersion: "carto.run/v1alpha1",
+				Kind:       templateKind,
+				Name:       name + "-template",
+			}).
+			StampedRef(&cartov1alpha1.StampedRef{
+				ObjectReference: &corev1.ObjectReference{
+					APIVersion: "kpack.io/v1alpha2",
+					Kind:       stampedKind,
+					Namespace:  defaultNamespace,
+					Name:       workloadName,
+				},
+			}).
+			Outputs(cartov1alpha1.Output{Name: output, Preview: "preview"}).
+			DieRelease()
+	}
+	outputTemplate := func(kind, name string, spec map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "carto.run/v1alpha1",
+				"kind":       kind,
+				"metadata": map[string]interface{}{
+					"name": name,
+				},
+				"spec": spec,
+			},
+		}
+	}
+	imageProvider := outputResource("image-provider", "ClusterImageTemplate", "Image", cartov1alpha1.OutputImage)
+	imageTemplate := outputTemplate("ClusterImageTemplate", "image-provider-template", map[string]interface{}{
+		"imagePath": ".status.latestImage",
+	})
+	kpackImage := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "kpack.io/v1alpha2",
+			"kind":       "Image",
+			"metadata": map[string]interface{}{
+				"namespace": defaultNamespace,
+				"name":      workloadName,
+			},
+			"status": map[string]interface{}{
+				"latestImage": "registry.example.com/my-workload@sha256:978be33a7f0cbe89bf48fbb438846047a28e1298d6d10d0de2d64bdc102a9e69",
+				"labels": map[string]interface{}{
+					"app": "my-workload",
+					"env": "test",
+				},
+			},
+		},
+	}
+
 	pod2Die := diecorev1.PodBlank.
 		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
 			d.Name("pod2")
@@ -1466,105 +1698,385 @@ To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
 
 `,
 		}, {
-			Name: "get workload exported data",
-			Args: []string{workloadName, flags.ExportFlagName},
+			Name: "get workload events",
+			Args: []string{workloadName},
 			GivenObjects: []client.Object{
-				parent.
-					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-						d.AddLabel(apis.AppPartOfLabelName, workloadName)
-					}).
-					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
-						d.ConditionsDie(
-							diecartov1alpha1.WorkloadConditionReadyBlank.
-								Status(metav1.ConditionUnknown).Reason("Workload Reason").
-								Message("a hopefully informative message about what went wrong"),
-						)
-					}),
+				parent,
+				pod1Die,
+				workloadEvent,
+				podEvent,
+				podEventRepeated,
+				otherPodEvent,
 			},
 			ExpectOutput: `
----
-apiVersion: carto.run/v1alpha1
-kind: Workload
-metadata:
-  labels:
-    app.kubernetes.io/part-of: my-workload
-  name: my-workload
-  namespace: default
-spec: {}
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+Supply Chain reference not found.
+
+   Supply Chain resources not found.
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+🔔 Events
+   LAST SEEN   TYPE      REASON                OBJECT                 MESSAGE
+   11y         Warning   SupplyChainNotFound   Workload/my-workload   no supply chain found
+   10y         Warning   BackOff               Pod/pod1               Back-off pulling image "my-image"
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
 `,
 		}, {
-			Name: "get workload exported data in json format",
-			Args: []string{workloadName, flags.ExportFlagName, flags.OutputFlagName, printer.OutputFormatJson},
+			Name: "get workload events with limit",
+			Args: []string{workloadName, flags.EventsLimitFlagName, "1"},
 			GivenObjects: []client.Object{
-				parent.
-					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-						d.AddLabel(apis.AppPartOfLabelName, workloadName)
-						d.CreationTimestamp(metav1.Date(2021, time.September, 10, 15, 00, 00, 00, time.UTC))
-					}).
-					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
-						d.ConditionsDie(
-							diecartov1alpha1.WorkloadConditionReadyBlank.
-								Status(metav1.ConditionUnknown).
-								Reason("Workload Reason").
-								Message("a hopefully informative message about what went wrong"),
-						)
-					}),
+				parent,
+				pod1Die,
+				workloadEvent,
+				podEvent,
+				podEventRepeated,
+				otherPodEvent,
 			},
 			ExpectOutput: `
-{
-	"apiVersion": "carto.run/v1alpha1",
-	"kind": "Workload",
-	"metadata": {
-		"labels": {
-			"app.kubernetes.io/part-of": "my-workload"
-		},
-		"name": "my-workload",
-		"namespace": "default"
-	},
-	"spec": {}
-}
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+Supply Chain reference not found.
+
+   Supply Chain resources not found.
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+🔔 Events
+   LAST SEEN   TYPE      REASON    OBJECT     MESSAGE
+   10y         Warning   BackOff   Pod/pod1   Back-off pulling image "my-image"
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
 `,
 		}, {
-			Name: "get workload output data in yaml format",
-			Args: []string{workloadName, flags.OutputFlagName, "yaml"},
+			Name: "get workload without events",
+			Args: []string{workloadName, flags.EventsLimitFlagName, "0"},
 			GivenObjects: []client.Object{
-				parent.
-					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-						d.AddLabel(apis.AppPartOfLabelName, workloadName)
-					}).
-					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
-						d.ConditionsDie(
-							diecartov1alpha1.WorkloadConditionReadyBlank.
-								Status(metav1.ConditionUnknown).
-								Reason("Workload Reason").
-								Message("a hopefully informative message about what went wrong"),
-						)
-					}),
+				parent,
+				pod1Die,
+				workloadEvent,
+				podEvent,
 			},
 			ExpectOutput: `
----
-apiVersion: carto.run/v1alpha1
-kind: Workload
-metadata:
-  creationTimestamp: "1970-01-01T00:00:01Z"
-  labels:
-    app.kubernetes.io/part-of: my-workload
-  name: my-workload
-  namespace: default
-  resourceVersion: "999"
-spec: {}
-status:
-  conditions:
-  - lastTransitionTime: null
-    message: a hopefully informative message about what went wrong
-    reason: Workload Reason
-    status: Unknown
-    type: Ready
-  supplyChainRef: {}
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+Supply Chain reference not found.
+
+   Supply Chain resources not found.
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
 `,
 		}, {
-			Name: "get workload output data in json format",
-			Args: []string{workloadName, flags.OutputFlagName, "json"},
+			Name: "get error for listing events",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "EventList"),
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+Supply Chain reference not found.
+
+   Supply Chain resources not found.
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+Failed to list events:
+  inducing failure for list EventList
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
+`,
+		}, {
+			Name: "get workload output of image",
+			Args: []string{workloadName, flags.OutputOfFlagName, cartov1alpha1.OutputImage},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(imageProvider)
+					}),
+				imageTemplate,
+				kpackImage,
+			},
+			ExpectOutput: `
+registry.example.com/my-workload@sha256:978be33a7f0cbe89bf48fbb438846047a28e1298d6d10d0de2d64bdc102a9e69
+`,
+		}, {
+			Name: "get workload output of config",
+			Args: []string{workloadName, flags.OutputOfFlagName, cartov1alpha1.OutputConfig},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(outputResource("config-provider", "ClusterConfigTemplate", "Image", cartov1alpha1.OutputConfig))
+					}),
+				outputTemplate("ClusterConfigTemplate", "config-provider-template", map[string]interface{}{
+					"configPath": "status.labels",
+				}),
+				kpackImage,
+			},
+			ExpectOutput: `
+app: my-workload
+env: test
+`,
+		}, {
+			Name: "get workload output of without resource producing it",
+			Args: []string{workloadName, flags.OutputOfFlagName, cartov1alpha1.OutputURL},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(imageProvider)
+					}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: no resource of workload "my-workload" produces the url output
+`,
+		}, {
+			Name: "get workload output of without template",
+			Args: []string{workloadName, flags.OutputOfFlagName, cartov1alpha1.OutputImage},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(imageProvider)
+					}),
+				kpackImage,
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: template ClusterImageTemplate "image-provider-template" of resource "image-provider" not found
+`,
+		}, {
+			Name: "get workload output of without output path in template",
+			Args: []string{workloadName, flags.OutputOfFlagName, cartov1alpha1.OutputImage},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(imageProvider)
+					}),
+				outputTemplate("ClusterImageTemplate", "image-provider-template", map[string]interface{}{}),
+				kpackImage,
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: template ClusterImageTemplate "image-provider-template" does not define the image output
+`,
+		}, {
+			Name: "get workload output of without stamped object",
+			Args: []string{workloadName, flags.OutputOfFlagName, cartov1alpha1.OutputImage},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(imageProvider)
+					}),
+				imageTemplate,
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: Image "my-workload" of resource "image-provider" not found
+`,
+		}, {
+			Name: "get workload output of without value in stamped object",
+			Args: []string{workloadName, flags.OutputOfFlagName, cartov1alpha1.OutputImage},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(imageProvider)
+					}),
+				outputTemplate("ClusterImageTemplate", "image-provider-template", map[string]interface{}{
+					"imagePath": ".status.artifact.image",
+				}),
+				kpackImage,
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: output path ".status.artifact.image" not found in Image "my-workload"
+`,
+		}, {
+			Name: "get error for template of output",
+			Args: []string{workloadName, flags.OutputOfFlagName, cartov1alpha1.OutputImage},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(imageProvider)
+					}),
+				imageTemplate,
+				kpackImage,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "ClusterImageTemplate"),
+			},
+			ShouldError: true,
+		}, {
+			Name: "get workload exported data",
+			Args: []string{workloadName, flags.ExportFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.AppPartOfLabelName, workloadName)
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionUnknown).Reason("Workload Reason").
+								Message("a hopefully informative message about what went wrong"),
+						)
+					}),
+			},
+			ExpectOutput: `
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  labels:
+    app.kubernetes.io/part-of: my-workload
+  name: my-workload
+  namespace: default
+spec: {}
+`,
+		}, {
+			Name: "get workload exported data without history",
+			Args: []string{workloadName, flags.ExportFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.AppPartOfLabelName, workloadName)
+						d.AddAnnotation(apis.WorkloadHistoryAnnotationName, `{"limit":1,"revisions":[{"revision":1,"spec":{}}]}`)
+					}),
+			},
+			ExpectOutput: `
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  labels:
+    app.kubernetes.io/part-of: my-workload
+  name: my-workload
+  namespace: default
+spec: {}
+`,
+		}, {
+			Name: "get workload exported data in json format",
+			Args: []string{workloadName, flags.ExportFlagName, flags.OutputFlagName, printer.OutputFormatJson},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.AppPartOfLabelName, workloadName)
+						d.CreationTimestamp(metav1.Date(2021, time.September, 10, 15, 00, 00, 00, time.UTC))
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionUnknown).
+								Reason("Workload Reason").
+								Message("a hopefully informative message about what went wrong"),
+						)
+					}),
+			},
+			ExpectOutput: `
+{
+	"apiVersion": "carto.run/v1alpha1",
+	"kind": "Workload",
+	"metadata": {
+		"labels": {
+			"app.kubernetes.io/part-of": "my-workload"
+		},
+		"name": "my-workload",
+		"namespace": "default"
+	},
+	"spec": {}
+}
+`,
+		}, {
+			Name: "get workload output data in yaml format",
+			Args: []string{workloadName, flags.OutputFlagName, "yaml"},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.AppPartOfLabelName, workloadName)
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionUnknown).
+								Reason("Workload Reason").
+								Message("a hopefully informative message about what went wrong"),
+						)
+					}),
+			},
+			ExpectOutput: `
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: "1970-01-01T00:00:01Z"
+  labels:
+    app.kubernetes.io/part-of: my-workload
+  name: my-workload
+  namespace: default
+  resourceVersion: "999"
+spec: {}
+status:
+  conditions:
+  - lastTransitionTime: null
+    message: a hopefully informative message about what went wrong
+    reason: Workload Reason
+    status: Unknown
+    type: Ready
+  supplyChainRef: {}
+`,
+		}, {
+			Name: "get workload output data in json format",
+			Args: []string{workloadName, flags.OutputFlagName, "json"},
 			GivenObjects: []client.Object{
 				parent.
 					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
@@ -2062,6 +2574,440 @@ Knative Services
 
 To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
 
+`,
+		}, {
+			Name: "report in json format",
+			Args: []string{workloadName, flags.OutputFlagName, "report-json"},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.WorkloadTypeLabelName, "web")
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Source(&cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: url,
+								Ref: cartov1alpha1.GitRef{Branch: "main"},
+							},
+						})
+						d.ServiceClaims(cartov1alpha1.WorkloadServiceClaim{
+							Name: "database",
+							Ref: &cartov1alpha1.WorkloadServiceClaimReference{
+								APIVersion: "services.tanzu.vmware.com/v1alpha1",
+								Kind:       "PostgreSQL",
+								Name:       "my-prod-db",
+							},
+						})
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionFalse).Reason("OopsieDoodle").
+								Message("a hopefully informative message about what went wrong"),
+						)
+						d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: "source-to-url"})
+						d.Resources(
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("source-provider").
+								StampedRef(&cartov1alpha1.StampedRef{
+									Resource: "gitrepositories.source.toolkit.fluxcd.io",
+									ObjectReference: &corev1.ObjectReference{
+										Kind:      "GitRepository",
+										Namespace: defaultNamespace,
+										Name:      workloadName,
+									}}).
+								ConditionsResourceHealthyReadyTrueDie().
+								DieRelease(),
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("deliverable").
+								StampedRef(&cartov1alpha1.StampedRef{
+									ObjectReference: &corev1.ObjectReference{
+										Kind:      cartov1alpha1.DeliverableKind,
+										Namespace: defaultNamespace,
+										Name:      workloadName,
+									}}).
+								ConditionsResourceHealthyReadyTrueDie().
+								DieRelease(),
+						)
+					}),
+				deliverableBlank.
+					StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+						d.DeliveryRef(cartov1alpha1.ObjectReference{Kind: "ClusterDelivery", Name: "delivery-basic"})
+						d.Resources(
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("deployer").
+								StampedRef(&cartov1alpha1.StampedRef{
+									ObjectReference: &corev1.ObjectReference{
+										Kind:      "App",
+										Namespace: defaultNamespace,
+										Name:      workloadName,
+									}}).
+								DieRelease(),
+						)
+					}),
+				pod1Die.
+					SpecDie(func(d *diecorev1.PodSpecDie) {
+						d.ContainerDie("workload", func(d *diecorev1.ContainerDie) {})
+					}).
+					StatusDie(func(d *diecorev1.PodStatusDie) {
+						d.Phase(corev1.PodRunning)
+						d.ContainerStatusDie("workload", func(d *diecorev1.ContainerStatusDie) {
+							d.Ready(true)
+							d.RestartCount(2)
+						})
+					}),
+				ksvcDieWithURL,
+			},
+			ExpectOutput: `
+{
+	"kind": "WorkloadReport",
+	"apiVersion": "apps.tanzu.vmware.com/v1alpha1",
+	"overview": {
+		"name": "my-workload",
+		"namespace": "default",
+		"type": "web"
+	},
+	"source": {
+		"type": "git",
+		"git": {
+			"url": "https://example.com",
+			"ref": {
+				"branch": "main"
+			}
+		}
+	},
+	"supplyChain": {
+		"name": "source-to-url",
+		"resources": [
+			{
+				"name": "source-provider",
+				"ready": "True",
+				"healthy": "True",
+				"resource": "gitrepositories.source.toolkit.fluxcd.io/my-workload"
+			}
+		]
+	},
+	"delivery": {
+		"name": "delivery-basic",
+		"resources": [
+			{
+				"name": "deployer",
+				"resource": "App/my-workload"
+			}
+		]
+	},
+	"messages": [
+		{
+			"kind": "Workload",
+			"reason": "OopsieDoodle",
+			"message": "a hopefully informative message about what went wrong"
+		}
+	],
+	"services": [
+		{
+			"claim": "database",
+			"name": "my-prod-db",
+			"kind": "PostgreSQL",
+			"apiVersion": "services.tanzu.vmware.com/v1alpha1"
+		}
+	],
+	"pods": [
+		{
+			"name": "pod1",
+			"ready": "1/1",
+			"status": "Running",
+			"restarts": 2,
+			"creationTimestamp": "` + objTimeStamp.UTC().Format(time.RFC3339) + `"
+		}
+	],
+	"knativeServices": [
+		{
+			"name": "ksvc1",
+			"ready": "True",
+			"url": "https://example.com"
+		}
+	]
+}
+`,
+		}, {
+			Name:         "report in yaml format",
+			Args:         []string{workloadName, flags.OutputFlagName, "report-yaml"},
+			GivenObjects: []client.Object{parent},
+			ExpectOutput: `
+---
+apiVersion: apps.tanzu.vmware.com/v1alpha1
+kind: WorkloadReport
+knativeServices: []
+messages: []
+overview:
+  name: my-workload
+  namespace: default
+pods: []
+services: []
+supplyChain:
+  resources: []
+`,
+		}, {
+			Name:         "report list pods error",
+			Args:         []string{workloadName, flags.OutputFlagName, "report-json"},
+			GivenObjects: []client.Object{parent},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "PodList"),
+			},
+			ShouldError: true,
+		}, {
+			Name: "graph",
+			Args: []string{workloadName, flags.GraphFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("source-provider").
+								ConditionsDie(
+									diecartov1alpha1.WorkloadConditionResourceReadyBlank.
+										Status(metav1.ConditionTrue),
+								).DieRelease(),
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("image-builder").
+								Inputs(cartov1alpha1.Input{Name: "source-provider"}).
+								ConditionsDie(
+									diecartov1alpha1.WorkloadConditionResourceReadyBlank.
+										Status(metav1.ConditionFalse).Reason("BuildFailed"),
+								).DieRelease(),
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("config-provider").
+								Inputs(cartov1alpha1.Input{Name: "image-builder"}).
+								DieRelease(),
+						)
+					}),
+			},
+			ExpectOutput: `
+   source-provider (Ready)
+   └── image-builder (BuildFailed)
+       └── config-provider (<unknown>)
+`,
+		}, {
+			Name: "graph in mermaid format",
+			Args: []string{workloadName, flags.GraphFlagName + "=mermaid"},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("source-provider").
+								ConditionsDie(
+									diecartov1alpha1.WorkloadConditionResourceReadyBlank.
+										Status(metav1.ConditionTrue),
+								).DieRelease(),
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("image-builder").
+								Inputs(cartov1alpha1.Input{Name: "source-provider"}).
+								ConditionsDie(
+									diecartov1alpha1.WorkloadConditionResourceReadyBlank.
+										Status(metav1.ConditionFalse).Reason("BuildFailed"),
+								).DieRelease(),
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("config-provider").
+								Inputs(cartov1alpha1.Input{Name: "image-builder"}).
+								DieRelease(),
+						)
+					}),
+			},
+			ExpectOutput: `
+flowchart LR
+  n0["source-provider"]
+  n1["image-builder"]
+  n2["config-provider"]
+  n0 --> n1
+  n1 --> n2
+  classDef ready fill:#a6e3a1
+  classDef notready fill:#f38ba8
+  classDef unknown fill:#d3d3d3
+  class n0 ready
+  class n1 notready
+  class n2 unknown
+`,
+		}, {
+			Name:         "graph without resources",
+			Args:         []string{workloadName, flags.GraphFlagName},
+			GivenObjects: []client.Object{parent},
+			ExpectOutput: `
+Supply Chain resources not found.
+`,
+		}, {
+			Name:         "watch until deleted",
+			Args:         []string{workloadName, flags.WatchFlagName},
+			GivenObjects: []client.Object{parent},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					{Type: watch.Modified, Object: parent.
+						StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+							d.ConditionsDie(
+								diecartov1alpha1.WorkloadConditionReadyBlank.
+									Status(metav1.ConditionFalse).Reason("OopsieDoodle").
+									Message("a hopefully informative message about what went wrong"),
+							)
+						}).DieReleasePtr()},
+					{Type: watch.Modified, Object: parent.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.Name("other-workload")
+						}).DieReleasePtr()},
+					{Type: watch.Deleted, Object: parent.DieReleasePtr()},
+				})
+				return watchhelper.WithWatcher(ctx, fakeWatcher), nil
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+Supply Chain reference not found.
+
+   Supply Chain resources not found.
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
+
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+📦 Supply Chain
+   name:   <none>
+
+   Supply Chain resources not found.
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   Workload [OopsieDoodle]:   a hopefully informative message about what went wrong
+
+Workload "default/my-workload" was deleted
+`,
+		}, {
+			Name: "watch deliverable",
+			Args: []string{workloadName, flags.WatchFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("deliverable").
+								StampedRef(&cartov1alpha1.StampedRef{
+									ObjectReference: &corev1.ObjectReference{
+										Kind:      cartov1alpha1.DeliverableKind,
+										Namespace: defaultNamespace,
+										Name:      workloadName,
+									}}).
+								DieRelease(),
+						)
+					}),
+				deliverableBlank,
+			},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					// already printed
+					{Type: watch.Modified, Object: deliverableBlank.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.ResourceVersion("999")
+						}).DieReleasePtr()},
+					{Type: watch.Modified, Object: deliverableBlank.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.ResourceVersion("1000")
+						}).DieReleasePtr()},
+					{Type: watch.Modified, Object: deliverableBlank.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.Name("other-deliverable")
+							d.ResourceVersion("1001")
+						}).DieReleasePtr()},
+				})
+				ctx, cancel := context.WithTimeout(watchhelper.WithWatcher(ctx, fakeWatcher), 100*time.Millisecond)
+				t.Cleanup(cancel)
+				return ctx, nil
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+Supply Chain reference not found.
+
+   NAME   READY   HEALTHY   UPDATED   RESOURCE
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
+
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+Supply Chain reference not found.
+
+   NAME   READY   HEALTHY   UPDATED   RESOURCE
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+`,
+		}, {
+			Name:         "watch error",
+			Args:         []string{workloadName, flags.WatchFlagName},
+			GivenObjects: []client.Object{parent},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(true, config.Client, []watch.Event{})
+				return watchhelper.WithWatcher(ctx, fakeWatcher), nil
+			},
+			ShouldError: true,
+			ExpectOutput: `
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+Supply Chain reference not found.
+
+   Supply Chain resources not found.
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
 `,
 		},
 	}
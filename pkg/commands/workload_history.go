@@ -0,0 +1,390 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+type WorkloadHistoryOptions struct {
+	Namespace string
+	Name      string
+
+	Revision int
+}
+
+var (
+	_ validation.Validatable = (*WorkloadHistoryOptions)(nil)
+	_ cli.Executable         = (*WorkloadHistoryOptions)(nil)
+)
+
+func (opts *WorkloadHistoryOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	errs = errs.Also(validation.K8sName(opts.Namespace, flags.NamespaceFlagName))
+	errs = errs.Also(validation.K8sName(opts.Name, cli.NameArgumentName))
+	if opts.Revision < 0 {
+		errs = errs.Also(validation.ErrInvalidValue(opts.Revision, flags.RevisionFlagName))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadHistoryOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workload, history, err := getWorkloadHistory(ctx, c, opts.Namespace, opts.Name)
+	if err != nil || history == nil {
+		return err
+	}
+
+	if opts.Revision != 0 {
+		return opts.printRevision(c, workload, history)
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{
+		// none for now
+	}).With(func(h table.PrintHandler) {
+		h.TableHandler(opts.printColumns(), opts.print(history))
+	})
+	if err := tablePrinter.PrintObj(workload, c.Stdout); err != nil {
+		return err
+	}
+
+	c.Printf("\n")
+	if latest := history.LatestRevision(); latest != nil && equality.Semantic.DeepEqual(latest.Spec, workload.Spec) {
+		c.Infof("Workload %q is at revision %d\n", workload.Name, latest.Revision)
+	} else {
+		c.Infof("Workload %q was changed after the last recorded revision\n", workload.Name)
+	}
+	c.Infof("To view the changes in a revision: \"%s workload history %s %s <revision>\"\n", c.Name, workload.Name, flags.RevisionFlagName)
+	c.Printf("\n")
+
+	return nil
+}
+
+// printRevision shows the changes made to the workload spec by a single revision.
+func (opts *WorkloadHistoryOptions) printRevision(c *cli.Config, workload *cartov1alpha1.Workload, history *cartov1alpha1.WorkloadHistory) error {
+	revision := history.GetRevision(opts.Revision)
+	if revision == nil {
+		err := fmt.Errorf("revision %d not found", opts.Revision)
+		c.Eprintf("%s revision %d of workload %q not found\n", printer.Serrorf("Error:"), opts.Revision, workload.Name)
+		return cli.SilenceError(err)
+	}
+
+	var previous *cartov1alpha1.Workload
+	if before := history.GetRevision(opts.Revision - 1); before != nil {
+		previous = revisionWorkload(workload, before)
+	}
+	difference, _, err := printer.ResourceDiff(previous, revisionWorkload(workload, revision), c.Scheme)
+	if err != nil {
+		return err
+	}
+	c.Boldf("Revision %d by %s at %s:\n", revision.Revision, revisionAuthor(revision), revisionTimestamp(revision))
+	c.Printf("%s", difference)
+	return nil
+}
+
+func NewWorkloadHistoryCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadHistoryOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "history <name>",
+		Short: "List the revisions recorded for a workload",
+		Long: strings.TrimSpace(`
+List the revisions of a workload with who submitted them, when, and which fields of the workload
+spec changed.
+
+Revisions are recorded in the workload annotations by "workload create", "workload update" and
+"workload apply" once the number of revisions to keep is set with ` + flags.HistoryLimitFlagName + `. Changes
+made with other tools are not recorded.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload history my-workload", c.Name),
+			fmt.Sprintf("%s workload history my-workload %s 2", c.Name, flags.RevisionFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().IntVar(&opts.Revision, cli.StripDash(flags.RevisionFlagName), 0, "show the changes made by a single `revision`")
+
+	return cmd
+}
+
+func (opts *WorkloadHistoryOptions) print(history *cartov1alpha1.WorkloadHistory) func(workload *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+	return func(workload *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(history.Revisions))
+		for i := range history.Revisions {
+			revision := &history.Revisions[i]
+			row := metav1beta1.TableRow{
+				Object: runtime.RawExtension{Object: workload},
+			}
+			row.Cells = append(row.Cells,
+				revision.Revision,
+				revisionAuthor(revision),
+				revisionTimestamp(revision),
+				revisionChanges(history, revision),
+			)
+			rows = append(rows, row)
+		}
+		return rows, nil
+	}
+}
+
+func (opts *WorkloadHistoryOptions) printColumns() []metav1beta1.TableColumnDefinition {
+	return []metav1beta1.TableColumnDefinition{
+		{Name: "Revision", Type: "integer"},
+		{Name: "Author", Type: "string"},
+		{Name: "Date", Type: "string"},
+		{Name: "Changes", Type: "string"},
+	}
+}
+
+// getWorkloadHistory loads a workload and its history. The history is nil, and a message is
+// printed, when the workload has no history.
+func getWorkloadHistory(ctx context.Context, c *cli.Config, namespace, name string) (*cartov1alpha1.Workload, *cartov1alpha1.WorkloadHistory, error) {
+	workload := &cartov1alpha1.Workload{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, workload); err != nil {
+		if apierrs.IsNotFound(err) {
+			c.Errorf("Workload %q not found\n", fmt.Sprintf("%s/%s", namespace, name))
+			return nil, nil, cli.SilenceError(err)
+		}
+		return nil, nil, err
+	}
+
+	history, err := workload.GetHistory()
+	if err != nil {
+		return nil, nil, err
+	}
+	if history == nil || len(history.Revisions) == 0 {
+		c.Infof("No history recorded for workload %q, set %s when submitting the workload to record it.\n", workload.Name, flags.HistoryLimitFlagName)
+		return workload, nil, nil
+	}
+	return workload, history, nil
+}
+
+// revisionWorkload returns a copy of the workload with the spec of a revision.
+func revisionWorkload(workload *cartov1alpha1.Workload, revision *cartov1alpha1.WorkloadRevision) *cartov1alpha1.Workload {
+	return &cartov1alpha1.Workload{
+		TypeMeta: workload.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: workload.Namespace,
+			Name:      workload.Name,
+		},
+		Spec: *revision.Spec.DeepCopy(),
+	}
+}
+
+func revisionAuthor(revision *cartov1alpha1.WorkloadRevision) string {
+	if revision.Author == "" {
+		return "<unknown>"
+	}
+	return revision.Author
+}
+
+func revisionTimestamp(revision *cartov1alpha1.WorkloadRevision) string {
+	if revision.Timestamp == nil || revision.Timestamp.IsZero() {
+		return "<unknown>"
+	}
+	return revision.Timestamp.UTC().Format(time.RFC3339)
+}
+
+// revisionChanges lists the top level fields of the workload spec changed by a revision. The first
+// revision is compared to an empty spec, changes are unknown when the previous revision is no
+// longer in the history.
+func revisionChanges(history *cartov1alpha1.WorkloadHistory, revision *cartov1alpha1.WorkloadRevision) string {
+	previous := &cartov1alpha1.WorkloadSpec{}
+	if revision.Revision != 1 {
+		before := history.GetRevision(revision.Revision - 1)
+		if before == nil {
+			return "<unknown>"
+		}
+		previous = &before.Spec
+	}
+
+	left, err := specFields(previous)
+	if err != nil {
+		return "<unknown>"
+	}
+	right, err := specFields(&revision.Spec)
+	if err != nil {
+		return "<unknown>"
+	}
+	changes := []string{}
+	for field, value := range right {
+		if !equality.Semantic.DeepEqual(left[field], value) {
+			changes = append(changes, field)
+		}
+	}
+	for field := range left {
+		if _, ok := right[field]; !ok {
+			changes = append(changes, field)
+		}
+	}
+	sort.Strings(changes)
+	return printer.EmptyString(strings.Join(changes, ", "))
+}
+
+func specFields(spec *cartov1alpha1.WorkloadSpec) (map[string]interface{}, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	return fields, json.Unmarshal(b, &fields)
+}
+
+type revisionStampStashKey struct{}
+
+type revisionStamp struct {
+	author    string
+	timestamp time.Time
+}
+
+// StashRevisionStamp sets the author and time recorded for new workload revisions, instead of the
+// kubeconfig user and the current time.
+func StashRevisionStamp(ctx context.Context, author string, timestamp time.Time) context.Context {
+	return context.WithValue(ctx, revisionStampStashKey{}, revisionStamp{author: author, timestamp: timestamp})
+}
+
+func retrieveRevisionStamp(ctx context.Context, c *cli.Config) (string, metav1.Time) {
+	if stamp, ok := ctx.Value(revisionStampStashKey{}).(revisionStamp); ok {
+		return stamp.author, metav1.NewTime(stamp.timestamp)
+	}
+	return kubeConfigUser(c), metav1.Now()
+}
+
+// kubeConfigUser returns the name of the kubeconfig user for the context in use, or an empty string
+// when the kubeconfig cannot be loaded.
+func kubeConfigUser(c *cli.Config) string {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = c.KubeConfigFile
+	rawConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).RawConfig()
+	if err != nil {
+		return ""
+	}
+	contextName := c.CurrentContext
+	if contextName == "" {
+		contextName = rawConfig.CurrentContext
+	}
+	if kubeContext, ok := rawConfig.Contexts[contextName]; ok {
+		return kubeContext.AuthInfo
+	}
+	return ""
+}
+
+// recordHistory adds the spec of the workload about to be submitted to the history kept in its
+// annotations. The history of the current workload is carried over, or seeded with the current
+// spec the first time, and is only kept when --history-limit is set or the current workload
+// already has a history.
+func (opts *WorkloadOptions) recordHistory(ctx context.Context, c *cli.Config, current, workload *cartov1alpha1.Workload) error {
+	var history *cartov1alpha1.WorkloadHistory
+	if current != nil {
+		var err error
+		if history, err = current.GetHistory(); err != nil {
+			return err
+		}
+	}
+
+	if cmd := cli.CommandFromContext(ctx); cmd != nil && cmd.Flags().Changed(cli.StripDash(flags.HistoryLimitFlagName)) {
+		if opts.HistoryLimit == 0 {
+			return workload.SetHistory(nil)
+		}
+		if history == nil {
+			history = &cartov1alpha1.WorkloadHistory{}
+			if current != nil {
+				history.AddRevision("", nil, current.Spec)
+			}
+		}
+		history.Limit = opts.HistoryLimit
+	}
+	if history == nil {
+		return nil
+	}
+
+	author, timestamp := retrieveRevisionStamp(ctx, c)
+	history.AddRevision(author, &timestamp, workload.Spec)
+	return workload.SetHistory(history)
+}
+
+// recordApplyHistory records the history for the workload expected once the apply configuration
+// is applied, and sets the resulting history annotation on the apply configuration. The apply
+// configuration only holds the fields set by the command, so its spec is not a full revision.
+func (opts *WorkloadOptions) recordApplyHistory(ctx context.Context, c *cli.Config, current, workload, applyConfig *cartov1alpha1.Workload) error {
+	if err := opts.recordHistory(ctx, c, current, workload); err != nil {
+		return err
+	}
+	value, ok := workload.Annotations[apis.WorkloadHistoryAnnotationName]
+	if !ok {
+		delete(applyConfig.Annotations, apis.WorkloadHistoryAnnotationName)
+		return nil
+	}
+	if applyConfig.Annotations == nil {
+		applyConfig.Annotations = map[string]string{}
+	}
+	applyConfig.Annotations[apis.WorkloadHistoryAnnotationName] = value
+	return nil
+}
+
+// workloadDiff is printer.ResourceDiff for workloads, ignoring the history recorded in the
+// annotations.
+func workloadDiff(left, right *cartov1alpha1.Workload, c *cli.Config) (string, bool, error) {
+	return printer.ResourceDiff(withoutHistory(left), withoutHistory(right), c.Scheme)
+}
+
+func withoutHistory(workload *cartov1alpha1.Workload) *cartov1alpha1.Workload {
+	if workload == nil {
+		return nil
+	}
+	if _, ok := workload.Annotations[apis.WorkloadHistoryAnnotationName]; !ok {
+		return workload
+	}
+	workload = workload.DeepCopy()
+	delete(workload.Annotations, apis.WorkloadHistoryAnnotationName)
+	if len(workload.Annotations) == 0 {
+		workload.Annotations = nil
+	}
+	return workload
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+// workloadHistoryAnnotation returns the value of the workload history annotation for the history
+func workloadHistoryAnnotation(history *cartov1alpha1.WorkloadHistory) string {
+	workload := &cartov1alpha1.Workload{}
+	workload.SetHistory(history)
+	return workload.Annotations[apis.WorkloadHistoryAnnotationName]
+}
+
+// workloadRevisionTimestamp is the time recorded for the revisions submitted in the tests
+var workloadRevisionTimestamp = time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func TestWorkloadHistoryOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name: "valid options",
+			Validatable: &commands.WorkloadHistoryOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid name",
+			Validatable: &commands.WorkloadHistoryOptions{
+				Namespace: "default",
+				Name:      "My-Workload",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("My-Workload", cli.NameArgumentName),
+		},
+		{
+			Name: "invalid revision",
+			Validatable: &commands.WorkloadHistoryOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Revision:  -1,
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue(-1, flags.RevisionFlagName),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadHistoryCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	timestamp := metav1.NewTime(workloadRevisionTimestamp)
+	history := &cartov1alpha1.WorkloadHistory{
+		Limit: 5,
+		Revisions: []cartov1alpha1.WorkloadRevision{{
+			Revision: 1,
+			Spec: cartov1alpha1.WorkloadSpec{
+				Image: "ubuntu:bionic",
+			},
+		}, {
+			Revision:  2,
+			Author:    "alice",
+			Timestamp: &timestamp,
+			Spec: cartov1alpha1.WorkloadSpec{
+				Image: "ubuntu:focal",
+				Env: []corev1.EnvVar{
+					{Name: "FOO", Value: "bar"},
+				},
+			},
+		}},
+	}
+	parent := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+			d.AddAnnotation(apis.WorkloadHistoryAnnotationName, workloadHistoryAnnotation(history))
+		}).
+		SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+			d.Image("ubuntu:focal")
+			d.Env(corev1.EnvVar{Name: "FOO", Value: "bar"})
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "invalid args",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name: "list revisions",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+REVISION   AUTHOR      DATE                   CHANGES
+1          <unknown>   <unknown>              image
+2          alice       2023-01-02T03:04:05Z   env, image
+
+Workload "my-workload" is at revision 2
+To view the changes in a revision: "test workload history my-workload --revision <revision>"
+
+`,
+		},
+		{
+			Name: "changed after the last revision",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("ubuntu:jammy")
+					}),
+			},
+			ExpectOutput: `
+REVISION   AUTHOR      DATE                   CHANGES
+1          <unknown>   <unknown>              image
+2          alice       2023-01-02T03:04:05Z   env, image
+
+Workload "my-workload" was changed after the last recorded revision
+To view the changes in a revision: "test workload history my-workload --revision <revision>"
+
+`,
+		},
+		{
+			Name: "show revision",
+			Args: []string{workloadName, flags.RevisionFlagName, "2"},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+Revision 2 by alice at 2023-01-02T03:04:05Z:
+...
+  4,  4   |metadata:
+  5,  5   |  name: my-workload
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8     - |  image: ubuntu:bionic
+      8 + |  env:
+      9 + |  - name: FOO
+     10 + |    value: bar
+     11 + |  image: ubuntu:focal
+`,
+		},
+		{
+			Name: "revision not found",
+			Args: []string{workloadName, flags.RevisionFlagName, "3"},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: revision 3 of workload "my-workload" not found
+`,
+		},
+		{
+			Name: "no history",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Annotations(nil)
+					}),
+			},
+			ExpectOutput: `
+No history recorded for workload "my-workload", set --history-limit when submitting the workload to record it.
+`,
+		},
+		{
+			Name:        "workload not found",
+			Args:        []string{workloadName},
+			ShouldError: true,
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+		},
+		{
+			Name: "get error",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Workload"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadHistoryCommand)
+}
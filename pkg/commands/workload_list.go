@@ -25,9 +25,13 @@ import (
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
+	k8swatch "k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
@@ -36,6 +40,7 @@ import (
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
 )
 
@@ -43,7 +48,12 @@ type WorkloadListOptions struct {
 	Namespace     string
 	AllNamespaces bool
 	App           string
+	Selector      string
+	Type          string
+	Ready         string
+	SupplyChain   string
 	Output        string
+	Watch         bool
 }
 
 var (
@@ -64,9 +74,48 @@ func (opts *WorkloadListOptions) Validate(ctx context.Context) validation.FieldE
 	if opts.App != "" {
 		errs = errs.Also(validation.K8sName(opts.App, flags.AppFlagName))
 	}
+	if opts.Type != "" {
+		errs = errs.Also(validation.K8sLabelValue(opts.Type, flags.TypeFlagName))
+	}
+	if opts.Selector != "" {
+		if _, err := labels.Parse(opts.Selector); err != nil {
+			errs = errs.Also(validation.ErrInvalidValue(opts.Selector, flags.SelectorFlagName))
+		}
+	}
+	if opts.Ready != "" {
+		errs = errs.Also(validation.Enum(opts.Ready, flags.ReadyFlagName, []string{"true", "false", "unknown"}))
+	}
+	if opts.SupplyChain != "" {
+		errs = errs.Also(validation.K8sName(opts.SupplyChain, flags.SupplyChainFlagName))
+	}
 
 	if opts.Output != "" {
-		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+		errs = errs.Also(validateListOutput(opts.Output, opts.Watch))
+	}
+
+	return errs
+}
+
+// validateListOutput validates the output format of the list commands, only the table formats
+// can be streamed when watch is set.
+func validateListOutput(output string, watch bool) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	format, arg, _ := strings.Cut(output, "=")
+	switch format {
+	case printer.OutputFormatCustomColumns:
+		if _, err := printer.ParseCustomColumns(arg); err != nil {
+			errs = errs.Also(validation.ErrInvalidValue(output, flags.OutputFlagName))
+		}
+	case printer.OutputFormatJSONPath:
+		if _, err := printer.ParseJSONPath(format, arg); arg == "" || err != nil {
+			errs = errs.Also(validation.ErrInvalidValue(output, flags.OutputFlagName))
+		}
+	default:
+		errs = errs.Also(validation.Enum(output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml, printer.OutputFormatWide}))
+	}
+	if watch && format != printer.OutputFormatWide && format != printer.OutputFormatCustomColumns {
+		errs = errs.Also(validation.ErrMultipleOneOf(flags.WatchFlagName, flags.OutputFlagName))
 	}
 
 	return errs
@@ -74,29 +123,40 @@ func (opts *WorkloadListOptions) Validate(ctx context.Context) validation.FieldE
 
 func (opts *WorkloadListOptions) Exec(ctx context.Context, c *cli.Config) error {
 	workloads := &cartov1alpha1.WorkloadList{}
-	labels := map[string]string{}
-	if opts.App != "" {
-		labels[apis.AppPartOfLabelName] = opts.App
+	selector, err := opts.labelSelector()
+	if err != nil {
+		return err
 	}
-	if err := c.List(ctx, workloads, client.InNamespace(opts.Namespace), client.MatchingLabels(labels)); err != nil {
+	if err := c.List(ctx, workloads, client.InNamespace(opts.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
 		return err
 	}
+	workloads.Items = opts.filter(workloads.Items)
 
-	if opts.Output != "" {
+	format, arg, _ := strings.Cut(opts.Output, "=")
+	switch format {
+	case printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml, printer.OutputFormatJSONPath:
 		var list []printer.Object
 		for i := range workloads.Items {
 			list = append(list, &workloads.Items[i])
 		}
-		export, err := printer.OutputResources(list, printer.OutputFormat(opts.Output), c.Scheme)
+		var export string
+		var err error
+		if format == printer.OutputFormatJSONPath {
+			export, err = printer.OutputJSONPath(list, arg, c.Scheme)
+		} else {
+			export, err = printer.OutputResources(list, printer.OutputFormat(opts.Output), c.Scheme)
+			export = export + "\n"
+		}
 		if err != nil {
 			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output workload:"), err)
 			return cli.SilenceError(err)
 		}
 
-		c.Printf("%s\n", export)
+		c.Printf("%s", export)
 		return nil
 	}
 
+	headers := true
 	if len(workloads.Items) == 0 {
 		nsGet := &corev1.Namespace{}
 		if getErr := c.Get(ctx, types.NamespacedName{Name: opts.Namespace}, nsGet); getErr != nil && apierrors.IsNotFound(getErr) {
@@ -104,21 +164,90 @@ func (opts *WorkloadListOptions) Exec(ctx context.Context, c *cli.Config) error
 			return cli.SilenceError(getErr)
 		}
 		c.Infof("No workloads found.\n")
-		return nil
+		if !opts.Watch {
+			return nil
+		}
+	} else {
+		workloads = workloads.DeepCopy()
+		printer.SortByNamespaceAndName(workloads.Items)
+		if err := opts.tablePrinter(false).PrintObj(workloads, c.Stdout); err != nil {
+			return err
+		}
+		headers = false
+	}
+
+	if opts.Watch {
+		return opts.watch(ctx, c, selector, workloads.ResourceVersion, headers)
+	}
+	return nil
+}
+
+// watch prints a row each time a workload is added or changed after the resource version, and a
+// message when it is deleted. The headers are printed with the first row when requested.
+func (opts *WorkloadListOptions) watch(ctx context.Context, c *cli.Config, selector labels.Selector, resourceVersion string, headers bool) error {
+	watchClient, err := watch.GetWatcher(ctx, c)
+	if err != nil {
+		return err
+	}
+	eventWatcher, err := watchClient.Watch(ctx, &cartov1alpha1.WorkloadList{}, &client.ListOptions{
+		Namespace:     opts.Namespace,
+		LabelSelector: selector,
+		Raw:           &metav1.ListOptions{ResourceVersion: resourceVersion},
+	})
+	if err != nil {
+		return err
 	}
+	defer eventWatcher.Stop()
+
+	for {
+		select {
+		case event, ok := <-eventWatcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			workload, ok := event.Object.(*cartov1alpha1.Workload)
+			if !ok {
+				continue
+			}
+			workloads := &cartov1alpha1.WorkloadList{Items: opts.filter([]cartov1alpha1.Workload{*workload})}
+			if len(workloads.Items) == 0 {
+				continue
+			}
+			if event.Type == k8swatch.Deleted {
+				c.Infof("Workload %q was deleted\n", fmt.Sprintf("%s/%s", workload.Namespace, workload.Name))
+				continue
+			}
+			if err := opts.tablePrinter(!headers).PrintObj(workloads, c.Stdout); err != nil {
+				return err
+			}
+			headers = false
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
 
-	tablePrinter := table.NewTablePrinter(table.PrintOptions{
+func (opts *WorkloadListOptions) tablePrinter(noHeaders bool) *table.HumanReadablePrinter {
+	format, arg, _ := strings.Cut(opts.Output, "=")
+	if format == printer.OutputFormatCustomColumns {
+		// the format is checked when the options are validated
+		columns, _ := printer.ParseCustomColumns(arg)
+		return table.NewTablePrinter(table.PrintOptions{
+			WithNamespace: opts.AllNamespaces,
+			NoHeaders:     noHeaders,
+		}).With(func(h table.PrintHandler) {
+			h.TableHandler(customColumnDefinitions(columns), printCustomColumns(columns))
+		})
+	}
+	return table.NewTablePrinter(table.PrintOptions{
 		WithNamespace: opts.AllNamespaces,
+		Wide:          format == printer.OutputFormatWide,
+		NoHeaders:     noHeaders,
 	}).With(func(h table.PrintHandler) {
 		columns := opts.printColumns()
 		h.TableHandler(columns, opts.printList)
 		h.TableHandler(columns, opts.print)
 	})
-
-	workloads = workloads.DeepCopy()
-	printer.SortByNamespaceAndName(workloads.Items)
-
-	return tablePrinter.PrintObj(workloads, c.Stdout)
 }
 
 func NewWorkloadListCommand(ctx context.Context, c *cli.Config) *cobra.Command {
@@ -133,6 +262,13 @@ List workloads in a namespace or across all namespaces.
 		Example: strings.Join([]string{
 			fmt.Sprintf("%s workload list", c.Name),
 			fmt.Sprintf("%s workload list %s", c.Name, flags.AllNamespacesFlagName),
+			fmt.Sprintf("%s workload list %s 'environment in (dev,test)'", c.Name, flags.SelectorFlagName),
+			fmt.Sprintf("%s workload list %s web %s=false", c.Name, flags.TypeFlagName, flags.ReadyFlagName),
+			fmt.Sprintf("%s workload list %s source-to-url", c.Name, flags.SupplyChainFlagName),
+			fmt.Sprintf("%s workload list %s wide", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s workload list %s", c.Name, flags.WatchFlagName),
+			fmt.Sprintf("%s workload list %s custom-columns=NAME:.metadata.name,SUPPLY-CHAIN:.status.supplyChainRef.name", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s workload list %s jsonpath='{.items[*].metadata.name}'", c.Name, flags.OutputFlagName),
 		}, "\n"),
 		PreRunE: cli.ValidateE(ctx, opts),
 		RunE:    cli.ExecE(ctx, c, opts),
@@ -140,7 +276,16 @@ List workloads in a namespace or across all namespaces.
 
 	cli.AllNamespacesFlag(ctx, cmd, c, &opts.Namespace, &opts.AllNamespaces)
 	cmd.Flags().StringVar(&opts.App, cli.StripDash(flags.AppFlagName), "", "application `name` the workload is a part of")
-	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Workloads formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+	cmd.Flags().StringVarP(&opts.Selector, cli.StripDash(flags.SelectorFlagName), "l", "", "label `selector` to filter workloads on, supports '=', '==', '!=', 'in', 'notin' and 'exists' (e.g. -l key1=value1,key2!=value2)")
+	cmd.Flags().StringVarP(&opts.Type, cli.StripDash(flags.TypeFlagName), "t", "", "only list workloads of this `type`")
+	cmd.Flags().StringVar(&opts.Ready, cli.StripDash(flags.ReadyFlagName), "", "only list workloads whose Ready condition has this `status` (true, false or unknown)")
+	cmd.Flags().Lookup(cli.StripDash(flags.ReadyFlagName)).NoOptDefVal = "true"
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.ReadyFlagName), func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"true", "false", "unknown"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	cmd.Flags().StringVar(&opts.SupplyChain, cli.StripDash(flags.SupplyChainFlagName), "", "only list workloads selected by the cluster supply chain with this `name`")
+	cmd.Flags().BoolVarP(&opts.Watch, cli.StripDash(flags.WatchFlagName), "w", false, "after listing the workloads, watch for changes and print a row each time a workload is added or changed, and a message when it is deleted")
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Workloads formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"wide\", \"custom-columns=<header>:<json-path-expr>,...\", \"jsonpath=<template>\"")
 
 	return cmd
 }
@@ -157,7 +302,7 @@ func (opts *WorkloadListOptions) printList(workloads *cartov1alpha1.WorkloadList
 	return rows, nil
 }
 
-func (opts *WorkloadListOptions) print(workload *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+func (opts *WorkloadListOptions) print(workload *cartov1alpha1.Workload, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
 	now := time.Now()
 	row := metav1beta1.TableRow{
 		Object: runtime.RawExtension{Object: workload},
@@ -168,8 +313,10 @@ func (opts *WorkloadListOptions) print(workload *cartov1alpha1.Workload, _ table
 		labels = map[string]string{}
 	}
 
-	row.Cells = append(row.Cells, workload.Name,
-		printer.EmptyString(labels[apis.WorkloadTypeLabelName]))
+	row.Cells = append(row.Cells, workload.Name)
+	if opts.Type == "" {
+		row.Cells = append(row.Cells, printer.EmptyString(labels[apis.WorkloadTypeLabelName]))
+	}
 	if opts.App == "" {
 		row.Cells = append(row.Cells, printer.EmptyString(labels[apis.AppPartOfLabelName]))
 	}
@@ -177,21 +324,159 @@ func (opts *WorkloadListOptions) print(workload *cartov1alpha1.Workload, _ table
 		printer.ConditionStatus(printer.FindCondition(workload.Status.Conditions, cartov1alpha1.WorkloadConditionReady)),
 		printer.TimestampSince(workload.CreationTimestamp, now),
 	)
+	if printOpts.Wide {
+		reason := ""
+		if cond := printer.FindCondition(workload.Status.Conditions, cartov1alpha1.WorkloadConditionReady); cond != nil {
+			reason = cond.Reason
+		}
+		observedGeneration := ""
+		if workload.Status.ObservedGeneration != 0 {
+			observedGeneration = fmt.Sprintf("%d", workload.Status.ObservedGeneration)
+		}
+		row.Cells = append(row.Cells,
+			printer.EmptyString(workload.Status.SupplyChainRef.Name),
+			printer.EmptyString(workloadSource(workload)),
+			printer.EmptyString(reason),
+			printer.EmptyString(observedGeneration),
+			printer.EmptyString(workloadResourcesStatus(workload)),
+		)
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
 
 func (opts *WorkloadListOptions) printColumns() []metav1beta1.TableColumnDefinition {
 	cols := []metav1beta1.TableColumnDefinition{}
 
-	cols = append(cols, metav1beta1.TableColumnDefinition{Name: "Name", Type: "string"},
-		metav1beta1.TableColumnDefinition{Name: "Type", Type: "string"})
+	cols = append(cols, metav1beta1.TableColumnDefinition{Name: "Name", Type: "string"})
+	if opts.Type == "" {
+		cols = append(cols, metav1beta1.TableColumnDefinition{Name: "Type", Type: "string"})
+	}
 	if opts.App == "" {
 		cols = append(cols, metav1beta1.TableColumnDefinition{Name: "App", Type: "string"})
 	}
 	cols = append(cols,
 		metav1beta1.TableColumnDefinition{Name: "Ready", Type: "string"},
 		metav1beta1.TableColumnDefinition{Name: "Age", Type: "string"},
+		metav1beta1.TableColumnDefinition{Name: "Supply Chain", Type: "string", Priority: 1},
+		metav1beta1.TableColumnDefinition{Name: "Source", Type: "string", Priority: 1},
+		metav1beta1.TableColumnDefinition{Name: "Reason", Type: "string", Priority: 1},
+		metav1beta1.TableColumnDefinition{Name: "Observed Generation", Type: "string", Priority: 1},
+		metav1beta1.TableColumnDefinition{Name: "Resources", Type: "string", Priority: 1},
 	)
 
 	return cols
 }
+
+func customColumnDefinitions(columns []printer.CustomColumn) []metav1beta1.TableColumnDefinition {
+	cols := []metav1beta1.TableColumnDefinition{}
+	for _, column := range columns {
+		cols = append(cols, metav1beta1.TableColumnDefinition{Name: column.Header, Type: "string"})
+	}
+	return cols
+}
+
+func printCustomColumns(columns []printer.CustomColumn) func(*cartov1alpha1.WorkloadList, table.PrintOptions) ([]metav1beta1.TableRow, error) {
+	return func(workloads *cartov1alpha1.WorkloadList, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(workloads.Items))
+		for i := range workloads.Items {
+			row, err := customColumnsRow(&workloads.Items[i], columns)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, row)
+		}
+		return rows, nil
+	}
+}
+
+func customColumnsRow(obj runtime.Object, columns []printer.CustomColumn) (metav1beta1.TableRow, error) {
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: obj},
+	}
+	for _, column := range columns {
+		value, err := column.Value(obj)
+		if err != nil {
+			return row, err
+		}
+		row.Cells = append(row.Cells, value)
+	}
+	return row, nil
+}
+
+// workloadSource describes where the workload is built from, the git repository and ref, the
+// source image, the maven artifact or the pre-built image.
+func workloadSource(workload *cartov1alpha1.Workload) string {
+	spec := workload.Spec
+	if source := spec.Source; source != nil {
+		if git := source.Git; git != nil {
+			for _, ref := range []string{git.Ref.Commit, git.Ref.Tag, git.Ref.Branch} {
+				if ref != "" {
+					return fmt.Sprintf("%s@%s", git.URL, ref)
+				}
+			}
+			return git.URL
+		}
+		if source.Image != "" {
+			return source.Image
+		}
+	}
+	if maven := spec.GetMavenSource(); maven != nil {
+		return fmt.Sprintf("%s:%s:%s", maven.GroupId, maven.ArtifactId, maven.Version)
+	}
+	return spec.Image
+}
+
+// workloadResourcesStatus summarizes the Ready condition of each resource stamped for the workload,
+// in the order of the supply chain, e.g. "source-provider✔ image-provider✘ config-provider–".
+func workloadResourcesStatus(workload *cartov1alpha1.Workload) string {
+	resources := []string{}
+	for _, resource := range workload.Status.Resources {
+		ready := printer.FindCondition(resource.Conditions, cartov1alpha1.ConditionResourceReady)
+		resources = append(resources, resource.Name+printer.ConditionSymbol(ready))
+	}
+	return strings.Join(resources, " ")
+}
+
+// labelSelector combines the label selector with the app and type filters.
+func (opts *WorkloadListOptions) labelSelector() (labels.Selector, error) {
+	selector, err := labels.Parse(opts.Selector)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range map[string]string{
+		apis.AppPartOfLabelName:    opts.App,
+		apis.WorkloadTypeLabelName: opts.Type,
+	} {
+		if value == "" {
+			continue
+		}
+		requirement, err := labels.NewRequirement(key, selection.Equals, []string{value})
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*requirement)
+	}
+	return selector, nil
+}
+
+// filter drops the workloads that do not match the status filters, which cannot be expressed as
+// label selectors.
+func (opts *WorkloadListOptions) filter(workloads []cartov1alpha1.Workload) []cartov1alpha1.Workload {
+	filtered := []cartov1alpha1.Workload{}
+	for _, workload := range workloads {
+		if opts.SupplyChain != "" && workload.Status.SupplyChainRef.Name != opts.SupplyChain {
+			continue
+		}
+		if opts.Ready != "" {
+			status := metav1.ConditionUnknown
+			if cond := printer.FindCondition(workload.Status.Conditions, cartov1alpha1.WorkloadConditionReady); cond != nil && cond.Status != "" {
+				status = cond.Status
+			}
+			if !strings.EqualFold(string(status), opts.Ready) {
+				continue
+			}
+		}
+		filtered = append(filtered, workload)
+	}
+	return filtered
+}
@@ -17,21 +17,27 @@ limitations under the License.
 package commands_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	diecorev1 "dies.dev/apis/core/v1"
 	diemetav1 "dies.dev/apis/meta/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
 	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	watchhelper "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch"
+	watchfakes "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch/fake"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
 	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
@@ -82,7 +88,116 @@ func TestWorkloadListOptionsValidate(t *testing.T) {
 				Namespace: "default",
 				Output:    "myFormat",
 			},
-			ExpectFieldErrors: validation.EnumInvalidValue("myFormat", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+			ExpectFieldErrors: validation.EnumInvalidValue("myFormat", flags.OutputFlagName, []string{"json", "yaml", "yml", "wide"}),
+		},
+		{
+			Name: "wide output format",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Output:    "wide",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "custom-columns output format",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Output:    "custom-columns=NAME:.metadata.name,SUPPLY-CHAIN:.status.supplyChainRef.name",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid custom-columns output format",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Output:    "custom-columns=NAME",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("custom-columns=NAME", flags.OutputFlagName),
+		},
+		{
+			Name: "jsonpath output format",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Output:    "jsonpath={.items[*].metadata.name}",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid jsonpath output format",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Output:    "jsonpath={.items[*]",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("jsonpath={.items[*]", flags.OutputFlagName),
+		},
+		{
+			Name: "empty jsonpath output format",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Output:    "jsonpath=",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("jsonpath=", flags.OutputFlagName),
+		},
+		{
+			Name: "watch",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Output:    "wide",
+				Watch:     true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "watch with output",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Output:    "yaml",
+				Watch:     true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.WatchFlagName, flags.OutputFlagName),
+		},
+		{
+			Name: "filters",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace:   "default",
+				Selector:    "environment in (dev,test),!canary",
+				Type:        "web",
+				Ready:       "false",
+				SupplyChain: "source-to-url",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid selector",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Selector:  "environment in dev",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("environment in dev", flags.SelectorFlagName),
+		},
+		{
+			Name: "invalid type",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Type:      "web app",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("web app", flags.TypeFlagName),
+		},
+		{
+			Name: "invalid ready",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Ready:     "maybe",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("maybe", flags.ReadyFlagName, []string{"true", "false", "unknown"}),
+		},
+		{
+			Name: "invalid supply chain",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace:   "default",
+				SupplyChain: "Source-To-URL",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("Source-To-URL", flags.SupplyChainFlagName),
 		},
 	}
 
@@ -290,6 +405,105 @@ test-workload   <empty>   <empty>   <unknown>   2y
 			ExpectOutput: `
 NAME            TYPE   APP     READY   AGE
 test-workload   web    hello   Ready   2y
+`,
+		},
+		{
+			Name: "lists items in wide format",
+			Args: []string{flags.OutputFlagName, "wide"},
+			GivenObjects: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Source(&cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: "https://example.com/spring-petclinic.git",
+								Ref: cartov1alpha1.GitRef{Branch: "main"},
+							},
+						})
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ObservedGeneration(2)
+						d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: "source-to-url"})
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionFalse).Reason("MissingValueAtPath"),
+						)
+						d.Resources(
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("source-provider").
+								ConditionsDie(
+									diecartov1alpha1.WorkloadConditionResourceReadyBlank.Status(metav1.ConditionTrue),
+								).DieRelease(),
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("image-provider").
+								ConditionsDie(
+									diecartov1alpha1.WorkloadConditionResourceReadyBlank.Status(metav1.ConditionFalse).Reason("MissingValueAtPath"),
+								).DieRelease(),
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("config-provider").
+								ConditionsDie(
+									diecartov1alpha1.WorkloadConditionResourceReadyBlank.Status(metav1.ConditionUnknown),
+								).DieRelease(),
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("deliverable").
+								DieRelease(),
+						)
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("maven-workload")
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Params(cartov1alpha1.Param{
+							Name:  "maven",
+							Value: apiextensionsv1.JSON{Raw: []byte(`{"artifactId":"spring-petclinic","groupId":"org.springframework.samples","version":"2.6.0"}`)},
+						})
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadOtherName)
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("ubuntu:jammy")
+					}),
+			},
+			ExpectOutput: `
+NAME                  TYPE      APP       READY                AGE   SUPPLY CHAIN    SOURCE                                               REASON               OBSERVED GENERATION   RESOURCES
+maven-workload        <empty>   <empty>   <unknown>            2y    <empty>         org.springframework.samples:spring-petclinic:2.6.0   <empty>              <empty>               <empty>
+test-other-workload   <empty>   <empty>   <unknown>            2y    <empty>         ubuntu:jammy                                         <empty>              <empty>               <empty>
+test-workload         <empty>   <empty>   MissingValueAtPath   2y    source-to-url   https://example.com/spring-petclinic.git@main        MissingValueAtPath   2                     source-provider✔ image-provider✘ config-provider… deliverable–
+`,
+		},
+		{
+			Name: "lists items in custom-columns format",
+			Args: []string{flags.OutputFlagName, "custom-columns=NAME:.metadata.name,SUPPLY-CHAIN:.status.supplyChainRef.name"},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: "source-to-url"})
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadOtherName)
+					}),
+			},
+			ExpectOutput: `
+NAME                  SUPPLY-CHAIN
+test-other-workload   <none>
+test-workload         source-to-url
+`,
+		},
+		{
+			Name: "lists items in jsonpath format",
+			Args: []string{flags.OutputFlagName, "jsonpath={range .items[*]}{.kind}/{.metadata.name}{\"\\n\"}{end}"},
+			GivenObjects: []client.Object{
+				parent,
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadOtherName)
+					}),
+			},
+			ExpectOutput: `
+Workload/test-other-workload
+Workload/test-workload
 `,
 		},
 		{
@@ -309,6 +523,154 @@ test-workload   web    hello   Ready   2y
 			ExpectOutput: `
 NAME            TYPE      READY       AGE
 test-workload   <empty>   <unknown>   2y
+`,
+		},
+		{
+			Name: "filters by selector",
+			Args: []string{flags.SelectorFlagName, "environment in (dev,test),!canary"},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("environment", "dev")
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadOtherName)
+						d.AddLabel("environment", "dev")
+						d.AddLabel("canary", "true")
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("prod-workload")
+						d.AddLabel("environment", "prod")
+					}),
+			},
+			ExpectOutput: `
+NAME            TYPE      APP       READY       AGE
+test-workload   <empty>   <empty>   <unknown>   2y
+`,
+		},
+		{
+			Name: "filters by selector and app",
+			Args: []string{flags.SelectorFlagName, "environment=dev", flags.AppFlagName, "hello"},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("environment", "dev")
+						d.AddLabel(apis.AppPartOfLabelName, "hello")
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadOtherName)
+						d.AddLabel("environment", "dev")
+					}),
+			},
+			ExpectOutput: `
+NAME            TYPE      READY       AGE
+test-workload   <empty>   <unknown>   2y
+`,
+		},
+		{
+			Name: "filters by type",
+			Args: []string{flags.TypeFlagName, "web"},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.WorkloadTypeLabelName, "web")
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadOtherName)
+						d.AddLabel(apis.WorkloadTypeLabelName, "worker")
+					}),
+			},
+			ExpectOutput: `
+NAME            APP       READY       AGE
+test-workload   <empty>   <unknown>   2y
+`,
+		},
+		{
+			Name: "filters by ready status",
+			Args: []string{flags.ReadyFlagName + "=false"},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionFalse).Reason("TemplateRejectedByAPIServer"),
+						)
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadOtherName)
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionTrue),
+						)
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("unknown-workload")
+					}),
+			},
+			ExpectOutput: `
+NAME            TYPE      APP       READY                         AGE
+test-workload   <empty>   <empty>   TemplateRejectedByAPIServer   2y
+`,
+		},
+		{
+			Name: "filters by ready unknown status",
+			Args: []string{flags.ReadyFlagName + "=unknown"},
+			GivenObjects: []client.Object{
+				parent,
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadOtherName)
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionTrue),
+						)
+					}),
+			},
+			ExpectOutput: `
+NAME            TYPE      APP       READY       AGE
+test-workload   <empty>   <empty>   <unknown>   2y
+`,
+		},
+		{
+			Name: "filters by supply chain",
+			Args: []string{flags.SupplyChainFlagName, "source-to-url"},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: "source-to-url"})
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadOtherName)
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: "basic-image-to-url"})
+					}),
+			},
+			ExpectOutput: `
+NAME            TYPE      APP       READY       AGE
+test-workload   <empty>   <empty>   <unknown>   2y
+`,
+		},
+		{
+			Name: "filters out every workload",
+			Args: []string{flags.SupplyChainFlagName, "source-to-url"},
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+				parent,
+			},
+			ExpectOutput: `
+No workloads found.
 `,
 		},
 		{
@@ -360,6 +722,114 @@ Error: namespace "foo" not found, it may not exist or user does not have permiss
 NAMESPACE         NAME                  TYPE      APP       READY       AGE
 default           test-workload         <empty>   <empty>   <unknown>   2y
 other-namespace   test-other-workload   web       <empty>   <unknown>   2y
+`,
+		},
+		{
+			Name: "all namespace in custom-columns format",
+			Args: []string{flags.AllNamespacesFlagName, flags.OutputFlagName, "custom-columns=NAME:.metadata.name,TYPE:.metadata.labels.apps\\.tanzu\\.vmware\\.com/workload-type"},
+			GivenObjects: []client.Object{
+				otherNamespaceDie,
+				parent,
+				diecartov1alpha1.WorkloadBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("test-other-workload")
+						d.Namespace(otherNamespace)
+						d.CreationTimestamp(objTimeStamp)
+						d.AddLabel(apis.WorkloadTypeLabelName, "web")
+					}),
+			},
+			ExpectOutput: `
+NAMESPACE         NAME                  TYPE
+default           test-workload         <none>
+other-namespace   test-other-workload   web
+`,
+		},
+		{
+			Name: "watch",
+			Args: []string{flags.WatchFlagName, flags.ReadyFlagName + "=false"},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionFalse).Reason("OopsieDoodle"),
+						)
+					}),
+			},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					{Type: watch.Added, Object: parent.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.Name(workloadOtherName)
+						}).
+						StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+							d.ConditionsDie(
+								diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionFalse).Reason("MissingValueAtPath"),
+							)
+						}).DieReleasePtr()},
+					{Type: watch.Modified, Object: parent.
+						StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+							d.ConditionsDie(
+								diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionTrue),
+							)
+						}).DieReleasePtr()},
+					{Type: watch.Deleted, Object: parent.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.Name(workloadOtherName)
+						}).
+						StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+							d.ConditionsDie(
+								diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionFalse).Reason("MissingValueAtPath"),
+							)
+						}).DieReleasePtr()},
+				})
+				ctx, cancel := context.WithTimeout(watchhelper.WithWatcher(ctx, fakeWatcher), 100*time.Millisecond)
+				t.Cleanup(cancel)
+				return ctx, nil
+			},
+			ExpectOutput: `
+NAME            TYPE      APP       READY          AGE
+test-workload   <empty>   <empty>   OopsieDoodle   2y
+test-other-workload   <empty>   <empty>   MissingValueAtPath   2y
+Workload "default/test-other-workload" was deleted
+`,
+		},
+		{
+			Name: "watch empty",
+			Args: []string{flags.WatchFlagName},
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+			},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					{Type: watch.Added, Object: parent.DieReleasePtr()},
+				})
+				ctx, cancel := context.WithTimeout(watchhelper.WithWatcher(ctx, fakeWatcher), 100*time.Millisecond)
+				t.Cleanup(cancel)
+				return ctx, nil
+			},
+			ExpectOutput: `
+No workloads found.
+NAME            TYPE      APP       READY       AGE
+test-workload   <empty>   <empty>   <unknown>   2y
+`,
+		},
+		{
+			Name: "watch error",
+			Args: []string{flags.WatchFlagName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(true, config.Client, []watch.Event{})
+				return watchhelper.WithWatcher(ctx, fakeWatcher), nil
+			},
+			ShouldError: true,
+			ExpectOutput: `
+NAME            TYPE      APP       READY       AGE
+test-workload   <empty>   <empty>   <unknown>   2y
 `,
 		},
 		{
@@ -0,0 +1,294 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+const (
+	WorkloadReportOutputFormatJson = "report-json"
+	WorkloadReportOutputFormatYaml = "report-yaml"
+
+	WorkloadReportAPIVersion = "apps.tanzu.vmware.com/v1alpha1"
+	WorkloadReportKind       = "WorkloadReport"
+)
+
+// WorkloadReport holds the same details "workload get" prints for humans, with a stable schema for
+// tools to consume. Fields may be added to the schema, but existing fields are not renamed or
+// removed without changing the apiVersion.
+type WorkloadReport struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Overview        WorkloadReportOverview         `json:"overview"`
+	Source          *WorkloadReportSource          `json:"source,omitempty"`
+	SupplyChain     WorkloadReportSupplyChain      `json:"supplyChain"`
+	Delivery        *WorkloadReportDelivery        `json:"delivery,omitempty"`
+	Messages        []WorkloadReportMessage        `json:"messages"`
+	Services        []WorkloadReportService        `json:"services"`
+	Pods            []WorkloadReportPod            `json:"pods"`
+	KnativeServices []WorkloadReportKnativeService `json:"knativeServices"`
+}
+
+type WorkloadReportOverview struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Type      string `json:"type,omitempty"`
+}
+
+type WorkloadReportSource struct {
+	// Type is one of "git", "source image", "maven" or "image"
+	Type    string                     `json:"type"`
+	Git     *cartov1alpha1.GitSource   `json:"git,omitempty"`
+	Maven   *cartov1alpha1.MavenSource `json:"maven,omitempty"`
+	Image   string                     `json:"image,omitempty"`
+	SubPath string                     `json:"subPath,omitempty"`
+}
+
+type WorkloadReportSupplyChain struct {
+	Name      string                   `json:"name,omitempty"`
+	Resources []WorkloadReportResource `json:"resources"`
+}
+
+type WorkloadReportDelivery struct {
+	Name      string                   `json:"name,omitempty"`
+	Resources []WorkloadReportResource `json:"resources"`
+}
+
+type WorkloadReportResource struct {
+	Name    string       `json:"name"`
+	Ready   string       `json:"ready,omitempty"`
+	Healthy string       `json:"healthy,omitempty"`
+	Updated *metav1.Time `json:"updated,omitempty"`
+	// Resource is the stamped resource, as "<resource>/<name>" or "<kind>/<name>"
+	Resource string `json:"resource,omitempty"`
+}
+
+type WorkloadReportMessage struct {
+	// Kind is the kind of the resource reporting the message, "Workload" or "Deliverable"
+	Kind    string `json:"kind"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message"`
+}
+
+type WorkloadReportService struct {
+	Claim      string `json:"claim"`
+	Name       string `json:"name,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+type WorkloadReportPod struct {
+	Name              string          `json:"name"`
+	Ready             string          `json:"ready"`
+	Status            corev1.PodPhase `json:"status,omitempty"`
+	Restarts          int32           `json:"restarts"`
+	CreationTimestamp *metav1.Time    `json:"creationTimestamp,omitempty"`
+}
+
+type WorkloadReportKnativeService struct {
+	Name  string `json:"name"`
+	Ready string `json:"ready,omitempty"`
+	URL   string `json:"url,omitempty"`
+}
+
+// NewWorkloadReport collects the details of the workload, the deliverable, the pods and the
+// knative services stamped for it.
+func NewWorkloadReport(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) (*WorkloadReport, error) {
+	report := &WorkloadReport{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: WorkloadReportAPIVersion,
+			Kind:       WorkloadReportKind,
+		},
+		Overview: WorkloadReportOverview{
+			Name:      workload.Name,
+			Namespace: workload.Namespace,
+			Type:      workload.Labels[apis.WorkloadTypeLabelName],
+		},
+		Source: workloadReportSource(workload),
+		SupplyChain: WorkloadReportSupplyChain{
+			Name:      workload.Status.SupplyChainRef.Name,
+			Resources: []WorkloadReportResource{},
+		},
+		Messages:        []WorkloadReportMessage{},
+		Services:        []WorkloadReportService{},
+		Pods:            []WorkloadReportPod{},
+		KnativeServices: []WorkloadReportKnativeService{},
+	}
+
+	for i := range workload.Status.Resources {
+		resource := &workload.Status.Resources[i]
+		if resource.StampedRef != nil && resource.StampedRef.Kind == cartov1alpha1.DeliverableKind {
+			continue
+		}
+		report.SupplyChain.Resources = append(report.SupplyChain.Resources, workloadReportResource(resource))
+	}
+
+	var deliverable *cartov1alpha1.Deliverable
+	if ref := getWorkloadResourceByKind(workload, cartov1alpha1.DeliverableKind); ref != nil {
+		deliverable = &cartov1alpha1.Deliverable{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: ref.StampedRef.Namespace, Name: ref.StampedRef.Name}, deliverable); err != nil {
+			deliverable = nil
+		}
+	}
+	var deliverableReadyCond *metav1.Condition
+	if deliverable != nil {
+		deliverableReadyCond = printer.FindCondition(deliverable.Status.Conditions, cartov1alpha1.ConditionReady)
+		report.Delivery = &WorkloadReportDelivery{
+			Name:      deliverable.Status.DeliveryRef.Name,
+			Resources: []WorkloadReportResource{},
+		}
+		for i := range deliverable.Status.Resources {
+			report.Delivery.Resources = append(report.Delivery.Resources, workloadReportResource(&deliverable.Status.Resources[i]))
+		}
+	}
+
+	if !areAllResourcesReady(printer.FindCondition(workload.Status.Conditions, cartov1alpha1.WorkloadConditionReady), deliverableReadyCond) {
+		report.Messages = append(report.Messages, workloadReportMessages(cartov1alpha1.WorkloadKind, workload.Status.Conditions)...)
+		if deliverable != nil {
+			report.Messages = append(report.Messages, workloadReportMessages(cartov1alpha1.DeliverableKind, deliverable.Status.Conditions)...)
+		}
+	}
+
+	for _, claim := range workload.Spec.ServiceClaims {
+		service := WorkloadReportService{Claim: claim.Name}
+		if claim.Ref != nil {
+			service.Name = claim.Ref.Name
+			service.Kind = claim.Ref.Kind
+			service.APIVersion = claim.Ref.APIVersion
+		}
+		report.Services = append(report.Services, service)
+	}
+
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(workload.Namespace), client.MatchingLabels{cartov1alpha1.WorkloadLabelName: workload.Name}); err != nil {
+		return nil, err
+	}
+	printer.SortByNamespaceAndName(pods.Items)
+	for i := range pods.Items {
+		report.Pods = append(report.Pods, workloadReportPod(&pods.Items[i]))
+	}
+
+	// knative is optional on the cluster, like for the human view there are no services to report
+	// when they cannot be listed
+	ksvcs := &knativeservingv1.ServiceList{}
+	_ = c.List(ctx, ksvcs, client.InNamespace(workload.Namespace), client.MatchingLabels{cartov1alpha1.WorkloadLabelName: workload.Name})
+	printer.SortByNamespaceAndName(ksvcs.Items)
+	for _, ksvc := range ksvcs.Items {
+		service := WorkloadReportKnativeService{
+			Name: ksvc.Name,
+			URL:  ksvc.Status.URL,
+		}
+		if cond := printer.FindCondition(ksvc.Status.Conditions, knativeservingv1.ServiceConditionReady); cond != nil {
+			service.Ready = string(cond.Status)
+		}
+		report.KnativeServices = append(report.KnativeServices, service)
+	}
+
+	return report, nil
+}
+
+func workloadReportSource(workload *cartov1alpha1.Workload) *WorkloadReportSource {
+	spec := workload.Spec
+	if source := spec.Source; source != nil {
+		if source.Git != nil {
+			return &WorkloadReportSource{Type: "git", Git: source.Git, SubPath: source.Subpath}
+		}
+		if source.Image != "" {
+			return &WorkloadReportSource{Type: "source image", Image: source.Image, SubPath: source.Subpath}
+		}
+	}
+	if maven := spec.GetMavenSource(); maven != nil {
+		return &WorkloadReportSource{Type: "maven", Maven: maven}
+	}
+	if spec.Image != "" {
+		return &WorkloadReportSource{Type: "image", Image: spec.Image}
+	}
+	return nil
+}
+
+func workloadReportResource(resource *cartov1alpha1.RealizedResource) WorkloadReportResource {
+	report := WorkloadReportResource{Name: resource.Name}
+	if cond := printer.FindCondition(resource.Conditions, cartov1alpha1.ConditionResourceReady); cond != nil {
+		report.Ready = string(cond.Status)
+		if !cond.LastTransitionTime.IsZero() {
+			report.Updated = cond.LastTransitionTime.DeepCopy()
+		}
+	}
+	if cond := printer.FindCondition(resource.Conditions, cartov1alpha1.ConditionResourceHealthy); cond != nil {
+		report.Healthy = string(cond.Status)
+	}
+	if ref := resource.StampedRef; ref != nil && ref.Name != "" {
+		if ref.Resource != "" {
+			report.Resource = fmt.Sprintf("%s/%s", ref.Resource, ref.Name)
+		} else if ref.Kind != "" {
+			report.Resource = fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
+		}
+	}
+	return report
+}
+
+// workloadReportMessages returns the messages of the Ready condition and, when it tells something
+// else, of the ResourcesHealthy condition.
+func workloadReportMessages(kind string, conditions []metav1.Condition) []WorkloadReportMessage {
+	messages := []WorkloadReportMessage{}
+	readyCond := printer.FindCondition(conditions, cartov1alpha1.ConditionReady)
+	if readyCond == nil {
+		return messages
+	}
+	if strings.TrimSpace(readyCond.Message) != "" {
+		messages = append(messages, WorkloadReportMessage{Kind: kind, Reason: readyCond.Reason, Message: readyCond.Message})
+	}
+	healthyCond := printer.FindCondition(conditions, cartov1alpha1.ResourcesHealthy)
+	if healthyCond != nil && strings.TrimSpace(healthyCond.Message) != "" && healthyCond.Message != readyCond.Message {
+		messages = append(messages, WorkloadReportMessage{Kind: kind, Reason: healthyCond.Reason, Message: healthyCond.Message})
+	}
+	return messages
+}
+
+func workloadReportPod(pod *corev1.Pod) WorkloadReportPod {
+	ready := 0
+	restarts := int32(0)
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Ready {
+			ready++
+		}
+		restarts += status.RestartCount
+	}
+	report := WorkloadReportPod{
+		Name:     pod.Name,
+		Ready:    fmt.Sprintf("%d/%d", ready, len(pod.Spec.Containers)),
+		Status:   pod.Status.Phase,
+		Restarts: restarts,
+	}
+	if !pod.CreationTimestamp.IsZero() {
+		report.CreationTimestamp = pod.CreationTimestamp.DeepCopy()
+	}
+	return report
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+type WorkloadRollbackOptions struct {
+	Namespace string
+	Name      string
+
+	ToRevision     int
+	UpdateAttempts int
+	Yes            bool
+}
+
+var (
+	_ validation.Validatable = (*WorkloadRollbackOptions)(nil)
+	_ cli.Executable         = (*WorkloadRollbackOptions)(nil)
+)
+
+func (opts *WorkloadRollbackOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	errs = errs.Also(validation.K8sName(opts.Namespace, flags.NamespaceFlagName))
+	errs = errs.Also(validation.K8sName(opts.Name, cli.NameArgumentName))
+	if opts.ToRevision < 0 {
+		errs = errs.Also(validation.ErrInvalidValue(opts.ToRevision, flags.ToRevisionFlagName))
+	}
+	if opts.UpdateAttempts < 1 {
+		errs = errs.Also(validation.ErrInvalidValue(opts.UpdateAttempts, flags.UpdateAttemptsFlagName))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadRollbackOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workload, history, err := getWorkloadHistory(ctx, c, opts.Namespace, opts.Name)
+	if err != nil {
+		return err
+	}
+	if history == nil {
+		return cli.SilenceError(fmt.Errorf("no history recorded for workload %q", opts.Name))
+	}
+
+	revision := opts.targetRevision(history)
+	if revision == nil {
+		err := fmt.Errorf("revision %d not found", opts.ToRevision)
+		if opts.ToRevision == 0 {
+			err = fmt.Errorf("no previous revision recorded")
+		}
+		c.Eprintf("%s unable to roll back workload %q: %s\n", printer.Serrorf("Error:"), workload.Name, err)
+		return cli.SilenceError(err)
+	}
+
+	c.Infof("Rolling back workload %q to revision %d\n", workload.Name, revision.Revision)
+	updateOpts := &WorkloadOptions{
+		Namespace:      opts.Namespace,
+		Name:           opts.Name,
+		Yes:            opts.Yes,
+		UpdateAttempts: opts.UpdateAttempts,
+	}
+	rollback := func(workload *cartov1alpha1.Workload) *cartov1alpha1.Workload {
+		workload.Spec = *revision.Spec.DeepCopy()
+		return workload
+	}
+	_, err = updateOpts.Update(ctx, c, workload, rollback(workload.DeepCopy()), rollback)
+	return err
+}
+
+// targetRevision returns the revision to roll back to, the one before the newest revision when no
+// revision is requested.
+func (opts *WorkloadRollbackOptions) targetRevision(history *cartov1alpha1.WorkloadHistory) *cartov1alpha1.WorkloadRevision {
+	if opts.ToRevision != 0 {
+		return history.GetRevision(opts.ToRevision)
+	}
+	if len(history.Revisions) < 2 {
+		return nil
+	}
+	return &history.Revisions[len(history.Revisions)-2]
+}
+
+func NewWorkloadRollbackCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadRollbackOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "rollback <name>",
+		Short: "Roll back a workload to a previous revision",
+		Long: strings.TrimSpace(`
+Roll back a workload to the spec of a revision recorded in its history, see "workload history" to
+list the revisions available. The metadata of the workload is kept as is, and the rollback is
+recorded in the history as a new revision.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload rollback my-workload", c.Name),
+			fmt.Sprintf("%s workload rollback my-workload %s 2", c.Name, flags.ToRevisionFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().IntVar(&opts.ToRevision, cli.StripDash(flags.ToRevisionFlagName), 0, "`revision` to roll back to (defaults to the revision before the latest one)")
+	cmd.Flags().IntVar(&opts.UpdateAttempts, cli.StripDash(flags.UpdateAttemptsFlagName), 3, "maximum number of attempts to update the workload when it is modified by another user at the same time")
+	cmd.Flags().BoolVarP(&opts.Yes, cli.StripDash(flags.YesFlagName), "y", false, "accept all prompts")
+
+	return cmd
+}
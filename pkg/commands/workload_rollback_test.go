@@ -0,0 +1,334 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"context"
+	"testing"
+
+	diemetav1 "dies.dev/apis/meta/v1"
+	expect "github.com/Netflix/go-expect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestWorkloadRollbackOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name: "valid options",
+			Validatable: &commands.WorkloadRollbackOptions{
+				Namespace:      "default",
+				Name:           "my-workload",
+				UpdateAttempts: 3,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid name",
+			Validatable: &commands.WorkloadRollbackOptions{
+				Namespace:      "default",
+				Name:           "My-Workload",
+				UpdateAttempts: 3,
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("My-Workload", cli.NameArgumentName),
+		},
+		{
+			Name: "invalid revision",
+			Validatable: &commands.WorkloadRollbackOptions{
+				Namespace:      "default",
+				Name:           "my-workload",
+				ToRevision:     -1,
+				UpdateAttempts: 3,
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue(-1, flags.ToRevisionFlagName),
+		},
+		{
+			Name: "invalid update attempts",
+			Validatable: &commands.WorkloadRollbackOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue(0, flags.UpdateAttemptsFlagName),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadRollbackCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	timestamp := metav1.NewTime(workloadRevisionTimestamp)
+	revisions := []cartov1alpha1.WorkloadRevision{{
+		Revision: 1,
+		Spec: cartov1alpha1.WorkloadSpec{
+			Image: "ubuntu:bionic",
+		},
+	}, {
+		Revision:  2,
+		Author:    "alice",
+		Timestamp: &timestamp,
+		Spec: cartov1alpha1.WorkloadSpec{
+			Image: "ubuntu:focal",
+		},
+	}, {
+		Revision:  3,
+		Author:    "bob",
+		Timestamp: &timestamp,
+		Spec: cartov1alpha1.WorkloadSpec{
+			Image: "ubuntu:jammy",
+		},
+	}}
+	history := &cartov1alpha1.WorkloadHistory{
+		Limit:     3,
+		Revisions: revisions,
+	}
+	parent := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+			d.AddLabel(apis.AppPartOfLabelName, "my-app")
+			d.AddAnnotation(apis.WorkloadHistoryAnnotationName, workloadHistoryAnnotation(history))
+		}).
+		SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+			d.Image("ubuntu:jammy")
+		})
+	prepareRevisionStamp := func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+		return commands.StashRevisionStamp(ctx, "carol", workloadRevisionTimestamp), nil
+	}
+	rolledBack := func(image string) *cartov1alpha1.Workload {
+		return &cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: defaultNamespace,
+				Name:      workloadName,
+				Labels: map[string]string{
+					apis.AppPartOfLabelName: "my-app",
+				},
+				Annotations: map[string]string{
+					apis.WorkloadHistoryAnnotationName: workloadHistoryAnnotation(&cartov1alpha1.WorkloadHistory{
+						Limit: 3,
+						Revisions: []cartov1alpha1.WorkloadRevision{
+							revisions[1],
+							revisions[2],
+							{
+								Revision:  4,
+								Author:    "carol",
+								Timestamp: &timestamp,
+								Spec: cartov1alpha1.WorkloadSpec{
+									Image: image,
+								},
+							},
+						},
+					}),
+				},
+			},
+			Spec: cartov1alpha1.WorkloadSpec{
+				Image: image,
+			},
+		}
+	}
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "invalid args",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:    "previous revision",
+			Args:    []string{workloadName, flags.YesFlagName},
+			Prepare: prepareRevisionStamp,
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectUpdates: []client.Object{
+				rolledBack("ubuntu:focal"),
+			},
+			ExpectOutput: `
+Rolling back workload "my-workload" to revision 2
+🔎 Update workload:
+...
+  6,  6   |    app.kubernetes.io/part-of: my-app
+  7,  7   |  name: my-workload
+  8,  8   |  namespace: default
+  9,  9   |spec:
+ 10     - |  image: ubuntu:jammy
+     10 + |  image: ubuntu:focal
+👍 Updated workload "my-workload"
+`,
+		},
+		{
+			Name:    "to revision",
+			Args:    []string{workloadName, flags.ToRevisionFlagName, "1"},
+			Prepare: prepareRevisionStamp,
+			GivenObjects: []client.Object{
+				parent,
+			},
+			WithConsoleInteractions: func(t *testing.T, c *expect.Console) {
+				c.ExpectString(clitesting.ToInteractTerminal("Really update the workload %q? [yN]: ", workloadName))
+				c.Send(clitesting.InteractInputLine("y"))
+				c.ExpectString(clitesting.ToInteractOutput("👍 Updated workload %q", workloadName))
+			},
+			ExpectUpdates: []client.Object{
+				rolledBack("ubuntu:bionic"),
+			},
+			ExpectOutput: `
+Rolling back workload "my-workload" to revision 1
+🔎 Update workload:
+...
+  6,  6   |    app.kubernetes.io/part-of: my-app
+  7,  7   |  name: my-workload
+  8,  8   |  namespace: default
+  9,  9   |spec:
+ 10     - |  image: ubuntu:jammy
+     10 + |  image: ubuntu:bionic
+` + clitesting.ToInteractTerminal("❓ Really update the workload \"my-workload\"? [yN]: y") + `
+
+👍 Updated workload "my-workload"`,
+		},
+		{
+			Name: "rollback refused",
+			Args: []string{workloadName, flags.ToRevisionFlagName, "1"},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			WithConsoleInteractions: func(t *testing.T, c *expect.Console) {
+				c.ExpectString(clitesting.ToInteractTerminal("Really update the workload %q? [yN]: ", workloadName))
+				c.Send(clitesting.InteractInputLine("n"))
+				c.ExpectString(clitesting.ToInteractOutput("Skipping workload %q", workloadName))
+			},
+			ExpectOutput: `
+Rolling back workload "my-workload" to revision 1
+🔎 Update workload:
+...
+  6,  6   |    app.kubernetes.io/part-of: my-app
+  7,  7   |  name: my-workload
+  8,  8   |  namespace: default
+  9,  9   |spec:
+ 10     - |  image: ubuntu:jammy
+     10 + |  image: ubuntu:bionic
+` + clitesting.ToInteractTerminal("❓ Really update the workload \"my-workload\"? [yN]: n") + `
+
+Skipping workload "my-workload"`,
+		},
+		{
+			Name: "unchanged",
+			Args: []string{workloadName, flags.ToRevisionFlagName, "3", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+Rolling back workload "my-workload" to revision 3
+Workload is unchanged, skipping update
+`,
+		},
+		{
+			Name: "revision not found",
+			Args: []string{workloadName, flags.ToRevisionFlagName, "5", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: unable to roll back workload "my-workload": revision 5 not found
+`,
+		},
+		{
+			Name: "no previous revision",
+			Args: []string{workloadName, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(apis.WorkloadHistoryAnnotationName, workloadHistoryAnnotation(&cartov1alpha1.WorkloadHistory{
+							Limit:     3,
+							Revisions: revisions[2:],
+						}))
+					}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: unable to roll back workload "my-workload": no previous revision recorded
+`,
+		},
+		{
+			Name: "no history",
+			Args: []string{workloadName, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Annotations(nil)
+					}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+No history recorded for workload "my-workload", set --history-limit when submitting the workload to record it.
+`,
+		},
+		{
+			Name:        "workload not found",
+			Args:        []string{workloadName, flags.YesFlagName},
+			ShouldError: true,
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+		},
+		{
+			Name:    "update error",
+			Args:    []string{workloadName, flags.YesFlagName},
+			Prepare: prepareRevisionStamp,
+			GivenObjects: []client.Object{
+				parent,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("update", "Workload"),
+			},
+			ExpectUpdates: []client.Object{
+				rolledBack("ubuntu:focal"),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Rolling back workload "my-workload" to revision 2
+🔎 Update workload:
+...
+  6,  6   |    app.kubernetes.io/part-of: my-app
+  7,  7   |  name: my-workload
+  8,  8   |  namespace: default
+  9,  9   |spec:
+ 10     - |  image: ubuntu:jammy
+     10 + |  image: ubuntu:focal
+`,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadRollbackCommand)
+}
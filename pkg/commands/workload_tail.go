@@ -19,12 +19,18 @@ package commands
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
@@ -36,13 +42,32 @@ import (
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
 )
 
+// WorkloadTailAllSteps tails the pods of all the steps of the supply chain, each line prefixed with
+// the name of its step
+const WorkloadTailAllSteps = "all"
+
+// workloadStepOwnersDepth is how many owners are followed from a pod to find the object stamped
+// by a step, e.g. a knative Revision runs its pods through a Deployment and a ReplicaSet
+const workloadStepOwnersDepth = 5
+
 type WorkloadTailOptions struct {
 	Namespace string
 	Name      string
 
 	Component  string
+	Step       string
+	Containers []string
 	Since      time.Duration
+	Follow     bool
+	Previous   bool
+	TailLines  int64
 	Timestamps bool
+	Grep       []string
+	Exclude    []string
+	Output     string
+
+	OutputDir     string
+	OutputMaxSize string
 }
 
 var (
@@ -67,7 +92,40 @@ func (opts *WorkloadTailOptions) Validate(ctx context.Context) validation.FieldE
 		errs = errs.Also(validation.ErrInvalidValue(opts.Since, flags.SinceFlagName))
 	}
 
+	if opts.TailLines < -1 {
+		errs = errs.Also(validation.ErrInvalidValue(opts.TailLines, flags.TailFlagName))
+	}
+
 	errs = errs.Also(validation.K8sLabelValue(opts.Component, flags.ComponentFlagName))
+	if opts.Step != "" && opts.Component != "" {
+		errs = errs.Also(validation.ErrMultipleOneOf(flags.StepFlagName, flags.ComponentFlagName))
+	}
+	if opts.Step != "" && opts.Follow && !opts.Previous {
+		// pods created once the command started would not be tailed
+		errs = errs.Also(validation.ErrDisallowedFields(flags.StepFlagName, "requires "+flags.FollowFlagName+"=false, the pods of the steps are only found when the command starts"))
+	}
+
+	for _, container := range opts.Containers {
+		errs = errs.Also(validation.K8sName(container, flags.ContainerFlagName))
+	}
+	for _, expression := range opts.Grep {
+		if _, err := regexp.Compile(expression); err != nil {
+			errs = errs.Also(validation.ErrInvalidValue(expression, flags.GrepFlagName))
+		}
+	}
+	for _, expression := range opts.Exclude {
+		if _, err := regexp.Compile(expression); err != nil {
+			errs = errs.Also(validation.ErrInvalidValue(expression, flags.ExcludeFlagName))
+		}
+	}
+	if opts.Output != "" {
+		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{logs.OutputJSON, logs.OutputRaw}))
+	}
+	if opts.OutputDir != "" {
+		if size, err := resource.ParseQuantity(opts.OutputMaxSize); err != nil || size.Sign() <= 0 {
+			errs = errs.Also(validation.ErrInvalidValue(opts.OutputMaxSize, flags.OutputMaxSizeFlagName))
+		}
+	}
 	return errs
 }
 
@@ -90,8 +148,141 @@ func (opts *WorkloadTailOptions) Exec(ctx context.Context, c *cli.Config) error
 	if err != nil {
 		panic(err)
 	}
+	var podGroups []logs.PodGroup
+	if opts.Step != "" {
+		podGroups, err = opts.stepPodGroups(ctx, c, workload, selector)
+		if err != nil {
+			return err
+		}
+		if len(podGroups) == 0 {
+			return nil
+		}
+	}
 	containers := []string{}
-	return logs.Tail(ctx, c, opts.Namespace, selector, containers, opts.Since, opts.Timestamps)
+	containers = append(containers, opts.Containers...)
+	var tailLines *int64
+	if opts.TailLines >= 0 {
+		tailLines = &opts.TailLines
+	}
+	since := opts.Since
+	if opts.Previous || tailLines != nil {
+		// the previous instance may have terminated long ago and the last lines may have been
+		// written long ago, their logs are not limited by the default duration
+		if cmd := cli.CommandFromContext(ctx); cmd == nil || !cmd.Flags().Changed(cli.StripDash(flags.SinceFlagName)) {
+			since = 0
+		}
+	}
+	var outputMaxSize int64
+	if opts.OutputDir != "" {
+		// the size is checked when the options are validated
+		size, _ := resource.ParseQuantity(opts.OutputMaxSize)
+		outputMaxSize = size.Value()
+	}
+	err = logs.Tail(ctx, c, opts.Namespace, selector, logs.TailOptions{
+		Containers: containers,
+		Since:      since,
+		Follow:     opts.Follow && !opts.Previous,
+		Previous:   opts.Previous,
+		TailLines:  tailLines,
+		Timestamps: opts.Timestamps,
+		PodGroups:  podGroups,
+		Include:    opts.Grep,
+		Exclude:    opts.Exclude,
+		Output:     opts.Output,
+
+		OutputDir:     opts.OutputDir,
+		OutputMaxSize: outputMaxSize,
+	})
+	if err == nil && opts.OutputDir != "" {
+		c.Einfof("Logs saved to %q, see %s for the files of each container\n", opts.OutputDir, logs.LogDirIndexFile)
+	}
+	return err
+}
+
+// stepPodGroups returns the pods created for the step, or for each step of the supply chain in
+// its order. The pods of a step are found following their owner references up to the object the
+// step stamped.
+func (opts *WorkloadTailOptions) stepPodGroups(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload, selector labels.Selector) ([]logs.PodGroup, error) {
+	resources := []cartov1alpha1.RealizedResource{}
+	for _, resource := range workload.Status.Resources {
+		if opts.Step == WorkloadTailAllSteps || resource.Name == opts.Step {
+			resources = append(resources, resource)
+		}
+	}
+	if len(resources) == 0 {
+		steps := []string{}
+		for _, resource := range workload.Status.Resources {
+			steps = append(steps, resource.Name)
+		}
+		if len(steps) == 0 {
+			c.Errorf("Workload %q has no supply chain steps\n", workload.Name)
+		} else {
+			c.Errorf("Step %q not found in workload %q, the steps are: %s\n", opts.Step, workload.Name, strings.Join(steps, ", "))
+		}
+		return nil, cli.SilenceError(fmt.Errorf("step %q not found", opts.Step))
+	}
+
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(workload.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	owners := map[string]*unstructured.Unstructured{}
+	groups := []logs.PodGroup{}
+	for _, resource := range resources {
+		if resource.StampedRef == nil || resource.StampedRef.ObjectReference == nil {
+			continue
+		}
+		group := logs.PodGroup{Pods: []string{}}
+		if opts.Step == WorkloadTailAllSteps {
+			group.Name = resource.Name
+		}
+		for _, pod := range pods.Items {
+			if ownedBy(ctx, c, pod.Namespace, pod.OwnerReferences, resource.StampedRef.ObjectReference, workloadStepOwnersDepth, owners) {
+				group.Pods = append(group.Pods, pod.Name)
+			}
+		}
+		if len(group.Pods) != 0 {
+			groups = append(groups, group)
+		}
+	}
+	if len(groups) == 0 {
+		if opts.Step == WorkloadTailAllSteps {
+			c.Infof("No pods found for the steps of workload %q\n", workload.Name)
+		} else {
+			c.Infof("No pods found for step %q of workload %q\n", opts.Step, workload.Name)
+		}
+	}
+	return groups, nil
+}
+
+// ownedBy returns whether one of the owners, or of their own owners up to depth, is the stamped
+// object. The owners fetched along the way are kept in the cache, nil when they cannot be fetched.
+func ownedBy(ctx context.Context, c *cli.Config, namespace string, refs []metav1.OwnerReference, stamped *corev1.ObjectReference, depth int, cache map[string]*unstructured.Unstructured) bool {
+	stampedGroup := schema.FromAPIVersionAndKind(stamped.APIVersion, stamped.Kind).Group
+	for _, ref := range refs {
+		gvk := schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind)
+		if gvk.Group == stampedGroup && ref.Kind == stamped.Kind && ref.Name == stamped.Name {
+			return true
+		}
+		if depth <= 1 {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s/%s", ref.APIVersion, ref.Kind, ref.Name)
+		owner, ok := cache[key]
+		if !ok {
+			owner = &unstructured.Unstructured{}
+			owner.SetGroupVersionKind(gvk)
+			if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, owner); err != nil {
+				owner = nil
+			}
+			cache[key] = owner
+		}
+		if owner != nil && ownedBy(ctx, c, namespace, owner.GetOwnerReferences(), stamped, depth-1, cache) {
+			return true
+		}
+	}
+	return false
 }
 
 func NewWorkloadTailCommand(ctx context.Context, c *cli.Config) *cobra.Command {
@@ -104,10 +295,22 @@ func NewWorkloadTailCommand(ctx context.Context, c *cli.Config) *cobra.Command {
 Stream logs for a workload until canceled. To cancel, press Ctl-c in
 the shell or stop the process. As new workload pods are started, the logs
 are displayed. To show historical logs use ` + flags.SinceFlagName + `.
+
+To print the logs written so far and exit, use ` + flags.FollowFlagName + `=false,
+along with ` + flags.TailFlagName + ` to only print the last lines of each container.
+To print the logs of the previous instance of the containers that restarted,
+e.g. while a pod is in CrashLoopBackOff, use ` + flags.PreviousFlagName + `.
 `),
 		Example: strings.Join([]string{
 			fmt.Sprintf("%s workload tail my-workload", c.Name),
 			fmt.Sprintf("%s workload tail my-workload %s 1h", c.Name, flags.SinceFlagName),
+			fmt.Sprintf("%s workload tail my-workload %s workload %s 'ERROR|WARN' %s health", c.Name, flags.ContainerFlagName, flags.GrepFlagName, flags.ExcludeFlagName),
+			fmt.Sprintf("%s workload tail my-workload %s json", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s workload tail my-workload %s=false %s 100 %s 1h", c.Name, flags.FollowFlagName, flags.TailFlagName, flags.SinceFlagName),
+			fmt.Sprintf("%s workload tail my-workload %s", c.Name, flags.PreviousFlagName),
+			fmt.Sprintf("%s workload tail my-workload %s image-provider %s=false", c.Name, flags.StepFlagName, flags.FollowFlagName),
+			fmt.Sprintf("%s workload tail my-workload %s %s %s=false", c.Name, flags.StepFlagName, WorkloadTailAllSteps, flags.FollowFlagName),
+			fmt.Sprintf("%s workload tail my-workload %s ./logs %s 50Mi", c.Name, flags.OutputDirFlagName, flags.OutputMaxSizeFlagName),
 		}, "\n"),
 		PreRunE:           cli.ValidateE(ctx, opts),
 		RunE:              cli.ExecE(ctx, c, opts),
@@ -121,8 +324,22 @@ are displayed. To show historical logs use ` + flags.SinceFlagName + `.
 	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
 	cmd.Flags().StringVar(&opts.Component, cli.StripDash(flags.ComponentFlagName), "", "workload component `name` (e.g. build)")
 	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.ComponentFlagName), completion.SuggestComponentNames(ctx, c))
+	cmd.Flags().StringVar(&opts.Step, cli.StripDash(flags.StepFlagName), "", "supply chain step `name` (e.g. image-provider) to print the logs of the pods it created, \"all\" for the pods of every step, requires "+flags.FollowFlagName+"=false")
 	cmd.Flags().BoolVarP(&opts.Timestamps, cli.StripDash(flags.TimestampFlagName), "t", false, "print timestamp for each log line")
 	cmd.Flags().DurationVar(&opts.Since, cli.StripDash(flags.SinceFlagName), time.Minute, "time `duration` to start reading logs from")
 	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.SinceFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
+	cmd.Flags().BoolVar(&opts.Follow, cli.StripDash(flags.FollowFlagName), true, "keep printing the logs as they are written, set to false to exit once the logs written so far are printed")
+	cmd.Flags().BoolVar(&opts.Previous, cli.StripDash(flags.PreviousFlagName), false, "print the logs of the previous instance of the containers that restarted, along with their restart count, and exit")
+	cmd.Flags().Int64Var(&opts.TailLines, cli.StripDash(flags.TailFlagName), -1, "number of `lines` to print from the end of the logs of each container, -1 to print all the lines (when set, the lines are only limited by "+flags.SinceFlagName+" if it is set too)")
+	cmd.Flags().StringSliceVar(&opts.Containers, cli.StripDash(flags.ContainerFlagName), []string{}, "only tail the container with this `name` (flag can be used multiple times)")
+	cmd.Flags().StringArrayVar(&opts.Grep, cli.StripDash(flags.GrepFlagName), []string{}, "only print the log lines matching the regular `expression` (flag can be used multiple times)")
+	cmd.Flags().StringArrayVar(&opts.Exclude, cli.StripDash(flags.ExcludeFlagName), []string{}, "do not print the log lines matching the regular `expression` (flag can be used multiple times)")
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the log lines formatted. Supported formats: \"json\", \"raw\"")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.OutputFlagName), func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{logs.OutputJSON, logs.OutputRaw}, cobra.ShellCompDirectiveNoFileComp
+	})
+	cmd.Flags().StringVar(&opts.OutputDir, cli.StripDash(flags.OutputDirFlagName), "", "`directory` to save the logs of each container to, in addition to printing them, as <directory>/<pod>/<container>.log")
+	cmd.MarkFlagDirname(cli.StripDash(flags.OutputDirFlagName))
+	cmd.Flags().StringVar(&opts.OutputMaxSize, cli.StripDash(flags.OutputMaxSizeFlagName), "10Mi", "`size` the files of "+flags.OutputDirFlagName+" are rotated at (e.g. 10Mi)")
 	return cmd
 }
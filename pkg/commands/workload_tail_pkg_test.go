@@ -0,0 +1,176 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+)
+
+// countingClient counts the objects fetched by the owners walk.
+type countingClient struct {
+	cli.Client
+	gets int
+}
+
+func (c *countingClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	c.gets++
+	return c.Client.Get(ctx, key, obj)
+}
+
+func TestOwnedBy(t *testing.T) {
+	owned := func(apiVersion, kind, name string, owner metav1.OwnerReference) client.Object {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace("default")
+		obj.SetName(name)
+		obj.SetOwnerReferences([]metav1.OwnerReference{owner})
+		return obj
+	}
+	ref := func(apiVersion, kind, name string) metav1.OwnerReference {
+		return metav1.OwnerReference{APIVersion: apiVersion, Kind: kind, Name: name}
+	}
+	image := &corev1.ObjectReference{APIVersion: "kpack.io/v1alpha2", Kind: "Image", Name: "my-workload"}
+	// a chain of owners from the pod to the image as deep as the owners followed for a step
+	chain := []client.Object{
+		owned("example.com/v1", "Link", "link-1", ref("example.com/v1", "Link", "link-2")),
+		owned("example.com/v1", "Link", "link-2", ref("example.com/v1", "Link", "link-3")),
+		owned("example.com/v1", "Link", "link-3", ref("example.com/v1", "Link", "link-4")),
+		owned("example.com/v1", "Link", "link-4", ref("kpack.io/v1alpha2", "Image", "my-workload")),
+	}
+
+	tests := []struct {
+		name         string
+		objects      []client.Object
+		refs         []metav1.OwnerReference
+		stamped      *corev1.ObjectReference
+		depth        int
+		expected     bool
+		expectedGets int
+		expectedNil  []string
+	}{{
+		name:     "owned by the stamped object",
+		refs:     []metav1.OwnerReference{ref("kpack.io/v1alpha2", "Image", "my-workload")},
+		stamped:  image,
+		depth:    1,
+		expected: true,
+	}, {
+		name:     "owned by another version of the stamped object",
+		refs:     []metav1.OwnerReference{ref("kpack.io/v1alpha1", "Image", "my-workload")},
+		stamped:  image,
+		depth:    1,
+		expected: true,
+	}, {
+		name:     "owned by the same kind in another group",
+		refs:     []metav1.OwnerReference{ref("images.example.com/v1alpha2", "Image", "my-workload")},
+		stamped:  image,
+		depth:    1,
+		expected: false,
+	}, {
+		name:     "owned by the same kind in the core group",
+		refs:     []metav1.OwnerReference{ref("v1", "Image", "my-workload")},
+		stamped:  image,
+		depth:    1,
+		expected: false,
+	}, {
+		name:     "owned by another object of the stamped kind",
+		refs:     []metav1.OwnerReference{ref("kpack.io/v1alpha2", "Image", "other-workload")},
+		stamped:  image,
+		depth:    1,
+		expected: false,
+	}, {
+		name: "owned through an owner",
+		objects: []client.Object{
+			owned("kpack.io/v1alpha2", "Build", "my-workload-build-1", ref("kpack.io/v1alpha2", "Image", "my-workload")),
+		},
+		refs:         []metav1.OwnerReference{ref("kpack.io/v1alpha2", "Build", "my-workload-build-1")},
+		stamped:      image,
+		depth:        2,
+		expected:     true,
+		expectedGets: 1,
+	}, {
+		name: "owner not fetched at the last level",
+		objects: []client.Object{
+			owned("kpack.io/v1alpha2", "Build", "my-workload-build-1", ref("kpack.io/v1alpha2", "Image", "my-workload")),
+		},
+		refs:     []metav1.OwnerReference{ref("kpack.io/v1alpha2", "Build", "my-workload-build-1")},
+		stamped:  image,
+		depth:    1,
+		expected: false,
+	}, {
+		name:         "owned through the deepest owners",
+		objects:      chain,
+		refs:         []metav1.OwnerReference{ref("example.com/v1", "Link", "link-1")},
+		stamped:      image,
+		depth:        workloadStepOwnersDepth,
+		expected:     true,
+		expectedGets: 4,
+	}, {
+		name:         "owned beyond the depth",
+		objects:      chain,
+		refs:         []metav1.OwnerReference{ref("example.com/v1", "Link", "link-1")},
+		stamped:      image,
+		depth:        workloadStepOwnersDepth - 1,
+		expected:     false,
+		expectedGets: 3,
+	}, {
+		name: "missing owners are fetched once",
+		refs: []metav1.OwnerReference{
+			ref("kpack.io/v1alpha2", "Build", "missing"),
+			ref("kpack.io/v1alpha2", "Build", "missing"),
+		},
+		stamped:      image,
+		depth:        2,
+		expected:     false,
+		expectedGets: 1,
+		expectedNil:  []string{"kpack.io/v1alpha2/Build/missing"},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := cli.NewDefaultConfig("test", runtime.NewScheme())
+			counting := &countingClient{
+				Client: clitesting.NewFakeCliClient(fake.NewClientBuilder().WithObjects(test.objects...).Build()),
+			}
+			c.Client = counting
+
+			cache := map[string]*unstructured.Unstructured{}
+			if actual := ownedBy(context.Background(), c, "default", test.refs, test.stamped, test.depth, cache); actual != test.expected {
+				t.Errorf("ownedBy() expected %v, got %v", test.expected, actual)
+			}
+			if counting.gets != test.expectedGets {
+				t.Errorf("ownedBy() expected %d owners fetched, got %d", test.expectedGets, counting.gets)
+			}
+			for _, key := range test.expectedNil {
+				if owner, ok := cache[key]; !ok || owner != nil {
+					t.Errorf("ownedBy() expected %q cached as nil", key)
+				}
+			}
+		})
+	}
+}
@@ -22,10 +22,14 @@ import (
 	"testing"
 	"time"
 
+	diecorev1 "dies.dev/apis/core/v1"
 	diemetav1 "dies.dev/apis/meta/v1"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -103,6 +107,118 @@ func TestWorkloadTailOptionsValidate(t *testing.T) {
 			},
 			ExpectFieldErrors: validation.ErrInvalidValue("---", flags.ComponentFlagName),
 		},
+		{
+			Name: "step and component",
+			Validatable: &commands.WorkloadTailOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Component: "build",
+				Step:      "image-provider",
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.StepFlagName, flags.ComponentFlagName),
+		},
+		{
+			Name: "step while following the logs",
+			Validatable: &commands.WorkloadTailOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Step:      "image-provider",
+				Follow:    true,
+			},
+			ExpectFieldErrors: validation.ErrDisallowedFields(flags.StepFlagName, "requires "+flags.FollowFlagName+"=false, the pods of the steps are only found when the command starts"),
+		},
+		{
+			Name: "step with previous logs",
+			Validatable: &commands.WorkloadTailOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Step:      "image-provider",
+				Follow:    true,
+				Previous:  true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "output dir",
+			Validatable: &commands.WorkloadTailOptions{
+				Namespace:     "default",
+				Name:          "my-workload",
+				OutputDir:     "logs",
+				OutputMaxSize: "10Mi",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output max size",
+			Validatable: &commands.WorkloadTailOptions{
+				Namespace:     "default",
+				Name:          "my-workload",
+				OutputDir:     "logs",
+				OutputMaxSize: "0",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("0", flags.OutputMaxSizeFlagName),
+		},
+		{
+			Name: "tail lines",
+			Validatable: &commands.WorkloadTailOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				TailLines: 100,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid tail lines",
+			Validatable: &commands.WorkloadTailOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				TailLines: -2,
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue(int64(-2), flags.TailFlagName),
+		},
+		{
+			Name: "filters and output",
+			Validatable: &commands.WorkloadTailOptions{
+				Namespace:  "default",
+				Name:       "my-workload",
+				Containers: []string{"workload"},
+				Grep:       []string{"ERROR|WARN"},
+				Exclude:    []string{"health"},
+				Output:     "json",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid container",
+			Validatable: &commands.WorkloadTailOptions{
+				Namespace:  "default",
+				Name:       "my-workload",
+				Containers: []string{"my-"},
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("my-", flags.ContainerFlagName),
+		},
+		{
+			Name: "invalid grep and exclude",
+			Validatable: &commands.WorkloadTailOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Grep:      []string{"ERROR("},
+				Exclude:   []string{"[health"},
+			},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrInvalidValue("ERROR(", flags.GrepFlagName),
+				validation.ErrInvalidValue("[health", flags.ExcludeFlagName),
+			),
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.WorkloadTailOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "yaml",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("yaml", flags.OutputFlagName, []string{"json", "raw"}),
+		},
 	}
 	table.Run(t)
 }
@@ -113,6 +229,7 @@ func TestWorkloadTailCommand(t *testing.T) {
 
 	scheme := runtime.NewScheme()
 	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
 
 	parent := diecartov1alpha1.WorkloadBlank.
 		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
@@ -120,6 +237,56 @@ func TestWorkloadTailCommand(t *testing.T) {
 			d.Namespace(defaultNamespace)
 		})
 
+	stampedResource := func(name, apiVersion, kind string) cartov1alpha1.RealizedResource {
+		return diecartov1alpha1.RealizedResourceBlank.
+			Name(name).
+			StampedRef(&cartov1alpha1.StampedRef{
+				ObjectReference: &corev1.ObjectReference{
+					APIVersion: apiVersion,
+					Kind:       kind,
+					Namespace:  defaultNamespace,
+					Name:       workloadName,
+				},
+			}).
+			DieRelease()
+	}
+	withSteps := parent.
+		StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+			d.Resources(
+				stampedResource("source-provider", "source.toolkit.fluxcd.io/v1beta1", "GitRepository"),
+				stampedResource("image-provider", "kpack.io/v1alpha2", "Image"),
+				stampedResource("config-writer", "carto.run/v1alpha1", "Runnable"),
+			)
+		})
+	ownedObject := func(apiVersion, kind, name string, owner metav1.OwnerReference) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace(defaultNamespace)
+		obj.SetName(name)
+		obj.SetOwnerReferences([]metav1.OwnerReference{owner})
+		return obj
+	}
+	build := ownedObject("kpack.io/v1alpha2", "Build", "test-workload-build-1",
+		metav1.OwnerReference{APIVersion: "kpack.io/v1alpha2", Kind: "Image", Name: workloadName})
+	taskRun := ownedObject("tekton.dev/v1beta1", "TaskRun", "test-workload-config-writer-abcde",
+		metav1.OwnerReference{APIVersion: "carto.run/v1alpha1", Kind: "Runnable", Name: workloadName})
+	pod := func(name string, owner metav1.OwnerReference) *diecorev1.PodDie {
+		return diecorev1.PodBlank.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name(name)
+				d.Namespace(defaultNamespace)
+				d.AddLabel(cartov1alpha1.WorkloadLabelName, workloadName)
+				d.OwnerReferences(owner)
+			})
+	}
+	buildPod := pod("test-workload-build-1-build-pod",
+		metav1.OwnerReference{APIVersion: "kpack.io/v1alpha2", Kind: "Build", Name: "test-workload-build-1"})
+	configWriterPod := pod("test-workload-config-writer-abcde-pod",
+		metav1.OwnerReference{APIVersion: "tekton.dev/v1beta1", Kind: "TaskRun", Name: "test-workload-config-writer-abcde"})
+	appPod := pod("test-workload-00001-deployment-abcde",
+		metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "test-workload-00001-deployment"})
+
 	table := clitesting.CommandTestSuite{
 		{
 			Name:        "empty",
@@ -160,7 +327,7 @@ Workload "default/test-workload" not found
 			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Hour, false).Return(nil).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Hour, Follow: true, Include: []string{}, Exclude: []string{}}).Return(nil).Once()
 				ctx = logs.StashTailer(ctx, tailer)
 				// simulate a user exit after 10ms
 				ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
@@ -185,7 +352,7 @@ Workload "default/test-workload" not found
 			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Minute, false).Return(nil).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Minute, Follow: true, Include: []string{}, Exclude: []string{}}).Return(nil).Once()
 				ctx = logs.StashTailer(ctx, tailer)
 				// simulate a user exit after 10ms
 				ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
@@ -210,7 +377,7 @@ Workload "default/test-workload" not found
 			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Second, false).Return(nil).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Second, Follow: true, Include: []string{}, Exclude: []string{}}).Return(nil).Once()
 				ctx = logs.StashTailer(ctx, tailer)
 				// simulate a user exit after 10ms
 				ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
@@ -235,7 +402,7 @@ Workload "default/test-workload" not found
 			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Second, false).Return(nil).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Second, Follow: true, Include: []string{}, Exclude: []string{}}).Return(nil).Once()
 				color.NoColor = false
 				ctx = logs.StashTailer(ctx, tailer)
 				// simulate a user exit after 10ms
@@ -260,7 +427,7 @@ Workload "default/test-workload" not found
 			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s,%s=%s", cartov1alpha1.WorkloadLabelName, workloadName, apis.ComponentLabelName, "build"))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Hour, false).Return(nil).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Hour, Follow: true, Include: []string{}, Exclude: []string{}}).Return(nil).Once()
 				ctx = logs.StashTailer(ctx, tailer)
 				// simulate a user exit after 10ms
 				ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
@@ -285,7 +452,7 @@ Workload "default/test-workload" not found
 			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Hour, false).Return(fmt.Errorf("tail error")).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Hour, Follow: true, Include: []string{}, Exclude: []string{}}).Return(fmt.Errorf("tail error")).Once()
 				ctx = logs.StashTailer(ctx, tailer)
 				return ctx, nil
 			},
@@ -308,7 +475,7 @@ Workload "default/test-workload" not found
 			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Hour, true).Return(nil).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Hour, Follow: true, Timestamps: true, Include: []string{}, Exclude: []string{}}).Return(nil).Once()
 				ctx = logs.StashTailer(ctx, tailer)
 				// simulate a user exit after 10ms
 				ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
@@ -325,6 +492,294 @@ Workload "default/test-workload" not found
 			},
 			ExpectOutput: `
 ...tail output...
+`,
+		},
+		{
+			Name: "show filtered logs for workload in json format",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, workloadName,
+				flags.ContainerFlagName, "workload", flags.GrepFlagName, "ERROR|WARN", flags.GrepFlagName, "panic", flags.ExcludeFlagName, "health,ready", flags.OutputFlagName, "json"},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				tailer := &logs.FakeTailer{}
+				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{
+					Containers: []string{"workload"},
+					Since:      time.Minute,
+					Follow:     true,
+					Include:    []string{"ERROR|WARN", "panic"},
+					Exclude:    []string{"health,ready"},
+					Output:     logs.OutputJSON,
+				}).Return(nil).Once()
+				ctx = logs.StashTailer(ctx, tailer)
+				// simulate a user exit after 10ms
+				ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+				_ = cancel
+				return ctx, nil
+			},
+			CleanUp: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) error {
+				tailer := logs.RetrieveTailer(ctx).(*logs.FakeTailer)
+				tailer.AssertExpectations(t)
+				return nil
+			},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+...tail output...
+`,
+		},
+		{
+			Name: "show last logs for workload without following them",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, workloadName, flags.FollowFlagName + "=false", flags.TailFlagName, "20", flags.SinceFlagName, "1h"},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				tailer := &logs.FakeTailer{}
+				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
+				tailLines := int64(20)
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{
+					Containers: []string{},
+					Since:      time.Hour,
+					TailLines:  &tailLines,
+					Include:    []string{},
+					Exclude:    []string{},
+				}).Return(nil).Once()
+				// no timeout, the command exits on its own once the logs are printed
+				return logs.StashTailer(ctx, tailer), nil
+			},
+			CleanUp: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) error {
+				tailer := logs.RetrieveTailer(ctx).(*logs.FakeTailer)
+				tailer.AssertExpectations(t)
+				return nil
+			},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+...tail output...
+`,
+		},
+		{
+			Name: "show last logs for workload written before the default since",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, workloadName, flags.FollowFlagName + "=false", flags.TailFlagName, "20"},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				tailer := &logs.FakeTailer{}
+				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
+				tailLines := int64(20)
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{
+					Containers: []string{},
+					TailLines:  &tailLines,
+					Include:    []string{},
+					Exclude:    []string{},
+				}).Return(nil).Once()
+				return logs.StashTailer(ctx, tailer), nil
+			},
+			CleanUp: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) error {
+				tailer := logs.RetrieveTailer(ctx).(*logs.FakeTailer)
+				tailer.AssertExpectations(t)
+				return nil
+			},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+...tail output...
+`,
+		},
+		{
+			Name: "show previous logs for workload",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, workloadName, flags.PreviousFlagName},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				tailer := &logs.FakeTailer{}
+				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{
+					Containers: []string{},
+					Previous:   true,
+					Include:    []string{},
+					Exclude:    []string{},
+				}).Return(nil).Once()
+				return logs.StashTailer(ctx, tailer), nil
+			},
+			CleanUp: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) error {
+				tailer := logs.RetrieveTailer(ctx).(*logs.FakeTailer)
+				tailer.AssertExpectations(t)
+				return nil
+			},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+...tail output...
+`,
+		},
+		{
+			Name: "show previous logs for workload since a duration",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, workloadName, flags.PreviousFlagName, flags.SinceFlagName, "1h", flags.TailFlagName, "50"},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				tailer := &logs.FakeTailer{}
+				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
+				tailLines := int64(50)
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{
+					Containers: []string{},
+					Since:      time.Hour,
+					Previous:   true,
+					TailLines:  &tailLines,
+					Include:    []string{},
+					Exclude:    []string{},
+				}).Return(nil).Once()
+				return logs.StashTailer(ctx, tailer), nil
+			},
+			CleanUp: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) error {
+				tailer := logs.RetrieveTailer(ctx).(*logs.FakeTailer)
+				tailer.AssertExpectations(t)
+				return nil
+			},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+...tail output...
+`,
+		},
+		{
+			Name: "show logs for a step of workload",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, workloadName, flags.StepFlagName, "image-provider", flags.FollowFlagName + "=false"},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				tailer := &logs.FakeTailer{}
+				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{
+					Containers: []string{},
+					Since:      time.Minute,
+					PodGroups: []logs.PodGroup{
+						{Pods: []string{"test-workload-build-1-build-pod"}},
+					},
+					Include: []string{},
+					Exclude: []string{},
+				}).Return(nil).Once()
+				return logs.StashTailer(ctx, tailer), nil
+			},
+			CleanUp: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) error {
+				tailer := logs.RetrieveTailer(ctx).(*logs.FakeTailer)
+				tailer.AssertExpectations(t)
+				return nil
+			},
+			GivenObjects: []client.Object{
+				withSteps,
+				build,
+				taskRun,
+				buildPod,
+				configWriterPod,
+				appPod,
+			},
+			ExpectOutput: `
+...tail output...
+`,
+		},
+		{
+			Name: "show logs for all the steps of workload",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, workloadName, flags.StepFlagName, "all", flags.FollowFlagName + "=false"},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				tailer := &logs.FakeTailer{}
+				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{
+					Containers: []string{},
+					Since:      time.Minute,
+					PodGroups: []logs.PodGroup{
+						{Name: "image-provider", Pods: []string{"test-workload-build-1-build-pod"}},
+						{Name: "config-writer", Pods: []string{"test-workload-config-writer-abcde-pod"}},
+					},
+					Include: []string{},
+					Exclude: []string{},
+				}).Return(nil).Once()
+				return logs.StashTailer(ctx, tailer), nil
+			},
+			CleanUp: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) error {
+				tailer := logs.RetrieveTailer(ctx).(*logs.FakeTailer)
+				tailer.AssertExpectations(t)
+				return nil
+			},
+			GivenObjects: []client.Object{
+				withSteps,
+				build,
+				taskRun,
+				buildPod,
+				configWriterPod,
+				appPod,
+			},
+			ExpectOutput: `
+...tail output...
+`,
+		},
+		{
+			Name: "show logs for a step without pods",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, workloadName, flags.StepFlagName, "source-provider", flags.FollowFlagName + "=false"},
+			GivenObjects: []client.Object{
+				withSteps,
+				build,
+				buildPod,
+				appPod,
+			},
+			ExpectOutput: `
+No pods found for step "source-provider" of workload "test-workload"
+`,
+		},
+		{
+			Name: "show logs for an unknown step",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, workloadName, flags.StepFlagName, "tests", flags.FollowFlagName + "=false"},
+			GivenObjects: []client.Object{
+				withSteps,
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Step "tests" not found in workload "test-workload", the steps are: source-provider, image-provider, config-writer
+`,
+		},
+		{
+			Name: "show logs for a step of workload without steps",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, workloadName, flags.StepFlagName, "all", flags.FollowFlagName + "=false"},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Workload "test-workload" has no supply chain steps
+`,
+		},
+		{
+			Name: "get error for listing pods of a step",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, workloadName, flags.StepFlagName, "image-provider", flags.FollowFlagName + "=false"},
+			GivenObjects: []client.Object{
+				withSteps,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "PodList"),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "save logs for workload",
+			Args: []string{flags.NamespaceFlagName, defaultNamespace, workloadName, flags.FollowFlagName + "=false", flags.OutputDirFlagName, "logs", flags.OutputMaxSizeFlagName, "1Mi"},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				tailer := &logs.FakeTailer{}
+				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{
+					Containers:    []string{},
+					Since:         time.Minute,
+					Include:       []string{},
+					Exclude:       []string{},
+					OutputDir:     "logs",
+					OutputMaxSize: 1024 * 1024,
+				}).Return(nil).Once()
+				return logs.StashTailer(ctx, tailer), nil
+			},
+			CleanUp: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) error {
+				tailer := logs.RetrieveTailer(ctx).(*logs.FakeTailer)
+				tailer.AssertExpectations(t)
+				return nil
+			},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+...tail output...
+Logs saved to "logs", see index.json for the files of each container
 `,
 		},
 	}
@@ -2486,7 +2486,7 @@ Updated workload "my-workload"
 
 			workload := currentWorkload.DeepCopy()
 			opts.ApplyOptionsToWorkload(ctx, workload)
-			_, err = opts.Update(ctx, c, currentWorkload, workload)
+			_, err = opts.Update(ctx, c, currentWorkload, workload, nil)
 
 			if err != nil && !test.shouldError {
 				t.Errorf("Update() errored %v", err)
@@ -2622,3 +2622,84 @@ spec:
 		})
 	}
 }
+
+func TestLoadInputWorkloads(t *testing.T) {
+	tests := []struct {
+		name        string
+		file        string
+		recursive   bool
+		expected    []string
+		shouldError bool
+	}{
+		{
+			name:     "loads workloads from file",
+			file:     "testdata/workloads.yaml",
+			expected: []string{"petclinic-api", "petclinic-ui"},
+		},
+		{
+			name:     "loads workloads from directory",
+			file:     "testdata/workloads-dir",
+			expected: []string{"petclinic-api", "petclinic-ui"},
+		},
+		{
+			name:      "loads workloads from directory recursively",
+			file:      "testdata/workloads-dir",
+			recursive: true,
+			expected:  []string{"petclinic-api", "petclinic-ui", "petclinic-worker"},
+		},
+		{
+			name:     "loads workloads from glob pattern",
+			file:     "testdata/workloads-dir/*/workload.yaml",
+			expected: []string{"petclinic-worker"},
+		},
+		{
+			name:     "loads workloads from glob pattern matching directories",
+			file:     "testdata/workloads-*",
+			expected: []string{"petclinic-api", "petclinic-ui"},
+		},
+		{
+			name:        "error with no files matching glob pattern",
+			file:        "testdata/missing/*.yaml",
+			shouldError: true,
+		},
+		{
+			name:        "error with invalid glob pattern",
+			file:        "testdata/[.yaml",
+			shouldError: true,
+		},
+		{
+			name:        "error with directory without workload files",
+			file:        "testdata/local-source",
+			shouldError: true,
+		},
+		{
+			name:        "error loading non-workload file from glob pattern",
+			file:        "testdata/*.jar",
+			shouldError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := &commands.WorkloadOptions{
+				FilePath:  test.file,
+				Recursive: test.recursive,
+			}
+
+			workloads, err := opts.LoadInputWorkloads(nil)
+
+			if (err == nil) == test.shouldError {
+				t.Errorf("LoadInputWorkloads() shouldErr %t, got %v", test.shouldError, err)
+			} else if test.shouldError {
+				return
+			}
+			names := []string{}
+			for _, workload := range workloads {
+				names = append(names, workload.Name)
+			}
+			if diff := cmp.Diff(test.expected, names); diff != "" {
+				t.Errorf("LoadInputWorkloads() (-want, +got) = %s", diff)
+			}
+		})
+	}
+}
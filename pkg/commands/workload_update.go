@@ -98,16 +98,21 @@ func (opts *WorkloadUpdateOptions) Exec(ctx context.Context, c *cli.Config) erro
 		return cli.SilenceError(err)
 	}
 	currentWorkload := workload.DeepCopy()
-	if opts.FilePath != "" {
-		var serviceAccountCopy string
-		// avoid passing a nil pointer to MergeServiceAccountName func
-		if fileWorkload.Spec.ServiceAccountName != nil {
-			serviceAccountCopy = *fileWorkload.Spec.ServiceAccountName
-		}
+	mergeFileWorkload := func(workload *cartov1alpha1.Workload) *cartov1alpha1.Workload {
+		fileWorkload := fileWorkload.DeepCopy()
+		if opts.FilePath != "" {
+			var serviceAccountCopy string
+			// avoid passing a nil pointer to MergeServiceAccountName func
+			if fileWorkload.Spec.ServiceAccountName != nil {
+				serviceAccountCopy = *fileWorkload.Spec.ServiceAccountName
+			}
 
-		workload.Spec.MergeServiceAccountName(serviceAccountCopy)
+			workload.Spec.MergeServiceAccountName(serviceAccountCopy)
+		}
+		workload.Merge(fileWorkload)
+		return workload
 	}
-	workload.Merge(fileWorkload)
+	workload = mergeFileWorkload(workload)
 
 	ctx = opts.ApplyOptionsToWorkload(ctx, workload)
 
@@ -125,6 +130,13 @@ func (opts *WorkloadUpdateOptions) Exec(ctx context.Context, c *cli.Config) erro
 		return err
 	}
 
+	if opts.StrictParams {
+		if err := checkStrictParams(ctx, c, workload); err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Error:"), err)
+			return cli.SilenceError(err)
+		}
+	}
+
 	if opts.DryRun {
 		cli.DryRunResource(ctx, workload, workload.GetGroupVersionKind())
 		return nil
@@ -137,7 +149,7 @@ func (opts *WorkloadUpdateOptions) Exec(ctx context.Context, c *cli.Config) erro
 		return nil
 	}
 
-	okToUpdate, err := opts.Update(ctx, c, currentWorkload, workload)
+	okToUpdate, err := opts.Update(ctx, c, currentWorkload, workload, mergeFileWorkload)
 	if err != nil {
 		return err
 	}
@@ -168,8 +180,12 @@ func (opts *WorkloadUpdateOptions) Exec(ctx context.Context, c *cli.Config) erro
 				if err != nil {
 					panic(err)
 				}
-				containers := []string{}
-				return logs.Tail(ctx, c, opts.Namespace, selector, containers, time.Minute, opts.TailTimestamps)
+				return logs.Tail(ctx, c, opts.Namespace, selector, logs.TailOptions{
+					Containers: []string{},
+					Since:      time.Minute,
+					Follow:     true,
+					Timestamps: opts.TailTimestamps,
+				})
 			})
 		}
 
@@ -224,6 +240,9 @@ Workload configuration options include:
 
 	// Define common flags
 	opts.DefineFlags(ctx, c, cmd)
+	cmd.Flags().Lookup(cli.StripDash(flags.FilePathFlagName)).Usage = "`file path` containing the description of a single workload, other flags are layered on top of this resource. Use value \"-\" to read from stdin"
+	cmd.Flags().IntVar(&opts.HistoryLimit, cli.StripDash(flags.HistoryLimitFlagName), 0, "`number` of workload revisions to record in the workload annotations, viewed with \"workload history\" (0 to stop recording, defaults to the number already set for the workload)")
+	cmd.Flags().IntVar(&opts.UpdateAttempts, cli.StripDash(flags.UpdateAttemptsFlagName), 3, "maximum number of attempts to update the workload when it is modified by another user at the same time")
 
 	return cmd
 }
@@ -25,6 +25,7 @@ import (
 
 	diecorev1 "dies.dev/apis/core/v1"
 	diemetav1 "dies.dev/apis/meta/v1"
+	"github.com/Netflix/go-expect"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/mock"
 	corev1 "k8s.io/api/core/v1"
@@ -87,6 +88,36 @@ func TestWorkloadUpdateOptionsValidate(t *testing.T) {
 			},
 			ExpectFieldErrors: validation.ErrInvalidArrayValue("FOO", flags.BuildEnvFlagName, 0),
 		},
+		{
+			Name: "invalid update attempts",
+			Validatable: &commands.WorkloadUpdateOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace:      "default",
+					Name:           "my-resource",
+					UpdateAttempts: 0,
+				},
+			},
+			Prepare: func(t *testing.T, ctx context.Context) (context.Context, error) {
+				cmd := commands.NewWorkloadUpdateCommand(ctx, cli.NewDefaultConfig("test", runtime.NewScheme()))
+				if err := cmd.Flags().Set(cli.StripDash(flags.UpdateAttemptsFlagName), "0"); err != nil {
+					return ctx, err
+				}
+				ctx = cli.WithCommand(ctx, cmd)
+				return ctx, nil
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue(0, flags.UpdateAttemptsFlagName),
+		},
+		{
+			Name: "invalid history limit",
+			Validatable: &commands.WorkloadUpdateOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace:    "default",
+					Name:         "my-resource",
+					HistoryLimit: -1,
+				},
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue(-1, flags.HistoryLimitFlagName),
+		},
 	}
 
 	table.Run(t)
@@ -112,6 +143,30 @@ func TestWorkloadUpdateCommand(t *testing.T) {
 			d.Name("spring-petclinic")
 			d.Namespace(defaultNamespace)
 		})
+
+	// concurrentUpdate changes the workload on the cluster right before the command updates it
+	// for the first time, so that update conflicts
+	var concurrentConfig *cli.Config
+	prepareConcurrentUpdate := func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+		concurrentConfig = config
+		return ctx, nil
+	}
+	concurrentUpdate := func(mutate func(workload *cartov1alpha1.Workload)) clitesting.ReactionFunc {
+		updated := false
+		return func(action clitesting.Action) (bool, runtime.Object, error) {
+			if updated || !action.Matches("update", "Workload") {
+				return false, nil, nil
+			}
+			updated = true
+			workload := &cartov1alpha1.Workload{}
+			if err := concurrentConfig.Get(context.Background(), client.ObjectKey{Namespace: defaultNamespace, Name: workloadName}, workload); err != nil {
+				return true, nil, err
+			}
+			mutate(workload)
+			return false, nil, concurrentConfig.Update(context.Background(), workload)
+		}
+	}
+
 	table := clitesting.CommandTestSuite{
 		{
 			Name:        "invalid args",
@@ -131,6 +186,174 @@ func TestWorkloadUpdateCommand(t *testing.T) {
 ❗ WARNING: the update command has been deprecated and will be removed in a future update. Please use "tanzu apps workload apply" instead.
 
 Workload is unchanged, skipping update
+`,
+		},
+		{
+			Name: "update records history",
+			Args: []string{workloadName, flags.ImageFlagName, "ubuntu:focal", flags.HistoryLimitFlagName, "2", flags.YesFlagName},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				return commands.StashRevisionStamp(ctx, "alice", workloadRevisionTimestamp), nil
+			},
+			GivenObjects: []client.Object{
+				parent.SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+					d.Image("ubuntu:bionic")
+				}),
+			},
+			ExpectUpdates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels:    map[string]string{},
+						Annotations: map[string]string{
+							apis.WorkloadHistoryAnnotationName: workloadHistoryAnnotation(&cartov1alpha1.WorkloadHistory{
+								Limit: 2,
+								Revisions: []cartov1alpha1.WorkloadRevision{{
+									Revision: 1,
+									Spec:     cartov1alpha1.WorkloadSpec{Image: "ubuntu:bionic"},
+								}, {
+									Revision:  2,
+									Author:    "alice",
+									Timestamp: &metav1.Time{Time: workloadRevisionTimestamp},
+									Spec:      cartov1alpha1.WorkloadSpec{Image: "ubuntu:focal"},
+								}},
+							}),
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:focal",
+					},
+				},
+			},
+			ExpectOutput: `
+❗ WARNING: the update command has been deprecated and will be removed in a future update. Please use "tanzu apps workload apply" instead.
+
+🔎 Update workload:
+...
+  4,  4   |metadata:
+  5,  5   |  name: my-workload
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8     - |  image: ubuntu:bionic
+      8 + |  image: ubuntu:focal
+👍 Updated workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name: "update keeps history",
+			Args: []string{workloadName, flags.ImageFlagName, "ubuntu:jammy", flags.YesFlagName},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				return commands.StashRevisionStamp(ctx, "bob", workloadRevisionTimestamp), nil
+			},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(apis.WorkloadHistoryAnnotationName, workloadHistoryAnnotation(&cartov1alpha1.WorkloadHistory{
+							Limit: 2,
+							Revisions: []cartov1alpha1.WorkloadRevision{
+								{Revision: 1, Spec: cartov1alpha1.WorkloadSpec{Image: "ubuntu:bionic"}},
+								{Revision: 2, Spec: cartov1alpha1.WorkloadSpec{Image: "ubuntu:focal"}},
+							},
+						}))
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("ubuntu:focal")
+					}),
+			},
+			ExpectUpdates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels:    map[string]string{},
+						Annotations: map[string]string{
+							apis.WorkloadHistoryAnnotationName: workloadHistoryAnnotation(&cartov1alpha1.WorkloadHistory{
+								Limit: 2,
+								Revisions: []cartov1alpha1.WorkloadRevision{{
+									Revision: 2,
+									Spec:     cartov1alpha1.WorkloadSpec{Image: "ubuntu:focal"},
+								}, {
+									Revision:  3,
+									Author:    "bob",
+									Timestamp: &metav1.Time{Time: workloadRevisionTimestamp},
+									Spec:      cartov1alpha1.WorkloadSpec{Image: "ubuntu:jammy"},
+								}},
+							}),
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:jammy",
+					},
+				},
+			},
+			ExpectOutput: `
+❗ WARNING: the update command has been deprecated and will be removed in a future update. Please use "tanzu apps workload apply" instead.
+
+🔎 Update workload:
+...
+  4,  4   |metadata:
+  5,  5   |  name: my-workload
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8     - |  image: ubuntu:focal
+      8 + |  image: ubuntu:jammy
+👍 Updated workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name: "update stops recording history",
+			Args: []string{workloadName, flags.ImageFlagName, "ubuntu:jammy", flags.HistoryLimitFlagName, "0", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddAnnotation(apis.WorkloadHistoryAnnotationName, workloadHistoryAnnotation(&cartov1alpha1.WorkloadHistory{
+							Limit: 2,
+							Revisions: []cartov1alpha1.WorkloadRevision{
+								{Revision: 1, Spec: cartov1alpha1.WorkloadSpec{Image: "ubuntu:focal"}},
+							},
+						}))
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("ubuntu:focal")
+					}),
+			},
+			ExpectUpdates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   defaultNamespace,
+						Name:        workloadName,
+						Labels:      map[string]string{},
+						Annotations: map[string]string{},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:jammy",
+					},
+				},
+			},
+			ExpectOutput: `
+❗ WARNING: the update command has been deprecated and will be removed in a future update. Please use "tanzu apps workload apply" instead.
+
+🔎 Update workload:
+...
+  4,  4   |metadata:
+  5,  5   |  name: my-workload
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8     - |  image: ubuntu:focal
+      8 + |  image: ubuntu:jammy
+👍 Updated workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
 `,
 		},
 		{
@@ -356,6 +579,54 @@ status:
 		},
 		{
 			Name: "conflict during update",
+			Args: []string{workloadName, flags.DebugFlagName, flags.UpdateAttemptsFlagName, "1", flags.YesFlagName},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("update", "Workload", clitesting.InduceFailureOpts{
+					Error: apierrors.NewConflict(schema.GroupResource{Group: "carto.run", Resource: "workloads"}, workloadName, fmt.Errorf("induced conflict")),
+				}),
+			},
+			GivenObjects: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("ubuntu:bionic")
+					}),
+			},
+			ExpectUpdates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels:    map[string]string{},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:bionic",
+						Params: []cartov1alpha1.Param{
+							{
+								Name:  "debug",
+								Value: apiextensionsv1.JSON{Raw: []byte(`"true"`)},
+							},
+						},
+					},
+				},
+			},
+			ShouldError: true,
+			ExpectOutput: `
+❗ WARNING: the update command has been deprecated and will be removed in a future update. Please use "tanzu apps workload apply" instead.
+
+🔎 Update workload:
+...
+  5,  5   |  name: my-workload
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8,  8   |  image: ubuntu:bionic
+      9 + |  params:
+     10 + |  - name: debug
+     11 + |    value: "true"
+Error: conflict updating workload, the object was modified by another user; please run the update command again
+`,
+		},
+		{
+			Name: "conflict during update - attempts exhausted",
 			Args: []string{workloadName, flags.DebugFlagName, flags.YesFlagName},
 			WithReactors: []clitesting.ReactionFunc{
 				clitesting.InduceFailure("update", "Workload", clitesting.InduceFailureOpts{
@@ -385,6 +656,38 @@ status:
 						},
 					},
 				},
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels:    map[string]string{},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:bionic",
+						Params: []cartov1alpha1.Param{
+							{
+								Name:  "debug",
+								Value: apiextensionsv1.JSON{Raw: []byte(`"true"`)},
+							},
+						},
+					},
+				},
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels:    map[string]string{},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:bionic",
+						Params: []cartov1alpha1.Param{
+							{
+								Name:  "debug",
+								Value: apiextensionsv1.JSON{Raw: []byte(`"true"`)},
+							},
+						},
+					},
+				},
 			},
 			ShouldError: true,
 			ExpectOutput: `
@@ -399,8 +702,195 @@ status:
       9 + |  params:
      10 + |  - name: debug
      11 + |    value: "true"
+Workload "my-workload" was modified by another user, retrying update (attempt 2 of 3)
+Workload "my-workload" was modified by another user, retrying update (attempt 3 of 3)
 Error: conflict updating workload, the object was modified by another user; please run the update command again
 `,
+		},
+		{
+			Name:    "conflict during update - retried without asking again",
+			Args:    []string{workloadName, flags.DebugFlagName},
+			Prepare: prepareConcurrentUpdate,
+			WithReactors: []clitesting.ReactionFunc{
+				concurrentUpdate(func(workload *cartov1alpha1.Workload) {
+					workload.Status.SupplyChainRef.Name = "source-to-url"
+				}),
+			},
+			GivenObjects: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("ubuntu:bionic")
+					}),
+			},
+			WithConsoleInteractions: func(t *testing.T, c *expect.Console) {
+				c.ExpectString(clitesting.ToInteractTerminal("Really update the workload %q? [yN]: ", workloadName))
+				c.Send(clitesting.InteractInputLine("y"))
+				c.ExpectString(clitesting.ToInteractOutput("👍 Updated workload %q", workloadName))
+			},
+			ExpectUpdates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels:    map[string]string{},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:bionic",
+						Params: []cartov1alpha1.Param{
+							{
+								Name:  "debug",
+								Value: apiextensionsv1.JSON{Raw: []byte(`"true"`)},
+							},
+						},
+					},
+				},
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels:    map[string]string{},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:bionic",
+					},
+					Status: cartov1alpha1.WorkloadStatus{
+						SupplyChainRef: cartov1alpha1.ObjectReference{
+							Name: "source-to-url",
+						},
+					},
+				},
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels:    map[string]string{},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:bionic",
+						Params: []cartov1alpha1.Param{
+							{
+								Name:  "debug",
+								Value: apiextensionsv1.JSON{Raw: []byte(`"true"`)},
+							},
+						},
+					},
+					Status: cartov1alpha1.WorkloadStatus{
+						SupplyChainRef: cartov1alpha1.ObjectReference{
+							Name: "source-to-url",
+						},
+					},
+				},
+			},
+			ExpectOutput: `
+❗ WARNING: the update command has been deprecated and will be removed in a future update. Please use "tanzu apps workload apply" instead.
+
+🔎 Update workload:
+...
+  5,  5   |  name: my-workload
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8,  8   |  image: ubuntu:bionic
+      9 + |  params:
+     10 + |  - name: debug
+     11 + |    value: "true"
+` + clitesting.ToInteractTerminal("❓ Really update the workload \"my-workload\"? [yN]: y") + `
+
+Workload "my-workload" was modified by another user, retrying update (attempt 2 of 3)
+👍 Updated workload "my-workload"`,
+		},
+		{
+			Name:    "conflict during update - asks again when the changes differ",
+			Args:    []string{workloadName, flags.DebugFlagName},
+			Prepare: prepareConcurrentUpdate,
+			WithReactors: []clitesting.ReactionFunc{
+				concurrentUpdate(func(workload *cartov1alpha1.Workload) {
+					workload.Spec.Image = "ubuntu:jammy"
+				}),
+			},
+			GivenObjects: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("ubuntu:bionic")
+					}),
+			},
+			WithConsoleInteractions: func(t *testing.T, c *expect.Console) {
+				c.ExpectString(clitesting.ToInteractTerminal("Really update the workload %q? [yN]: ", workloadName))
+				c.Send(clitesting.InteractInputLine("y"))
+				c.ExpectString(clitesting.ToInteractTerminal("Really update the workload %q? [yN]: ", workloadName))
+				c.Send(clitesting.InteractInputLine("y"))
+				c.ExpectString(clitesting.ToInteractOutput("👍 Updated workload %q", workloadName))
+			},
+			ExpectUpdates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels:    map[string]string{},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:bionic",
+						Params: []cartov1alpha1.Param{
+							{
+								Name:  "debug",
+								Value: apiextensionsv1.JSON{Raw: []byte(`"true"`)},
+							},
+						},
+					},
+				},
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels:    map[string]string{},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:jammy",
+					},
+				},
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels:    map[string]string{},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Image: "ubuntu:jammy",
+						Params: []cartov1alpha1.Param{
+							{
+								Name:  "debug",
+								Value: apiextensionsv1.JSON{Raw: []byte(`"true"`)},
+							},
+						},
+					},
+				},
+			},
+			ExpectOutput: `
+❗ WARNING: the update command has been deprecated and will be removed in a future update. Please use "tanzu apps workload apply" instead.
+
+🔎 Update workload:
+...
+  5,  5   |  name: my-workload
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8,  8   |  image: ubuntu:bionic
+      9 + |  params:
+     10 + |  - name: debug
+     11 + |    value: "true"
+` + clitesting.ToInteractTerminal("❓ Really update the workload \"my-workload\"? [yN]: y") + `
+
+Workload "my-workload" was modified by another user, retrying update (attempt 2 of 3)
+🔎 Update workload:
+...
+  5,  5   |  name: my-workload
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8,  8   |  image: ubuntu:jammy
+      9 + |  params:
+     10 + |  - name: debug
+     11 + |    value: "true"
+` + clitesting.ToInteractTerminal("❓ Really update the workload \"my-workload\"? [yN]: y") + `
+
+👍 Updated workload "my-workload"`,
 		},
 		{
 			Name: "wait error with timeout",
@@ -657,7 +1147,7 @@ Workload "my-workload" is ready
 
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Minute, false).Return(nil).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Minute, Follow: true}).Return(nil).Once()
 				ctx = logs.StashTailer(ctx, tailer)
 
 				return ctx, nil
@@ -744,7 +1234,7 @@ Workload "my-workload" is ready
 
 				tailer := &logs.FakeTailer{}
 				selector, _ := labels.Parse(fmt.Sprintf("%s=%s", cartov1alpha1.WorkloadLabelName, workloadName))
-				tailer.On("Tail", mock.Anything, "default", selector, []string{}, time.Minute, true).Return(nil).Once()
+				tailer.On("Tail", mock.Anything, "default", selector, logs.TailOptions{Containers: []string{}, Since: time.Minute, Follow: true, Timestamps: true}).Return(nil).Once()
 				ctx = logs.StashTailer(ctx, tailer)
 
 				return ctx, nil
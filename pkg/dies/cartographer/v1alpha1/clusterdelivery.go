@@ -0,0 +1,30 @@
+package v1alpha1
+
+import (
+	diemetav1 "dies.dev/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+)
+
+// +die:object=true,spec=DeliverySpec,status=DeliveryStatus
+type _ = cartov1alpha1.ClusterDelivery
+
+// +die
+type _ = cartov1alpha1.DeliverySpec
+
+// +die
+type _ = cartov1alpha1.DeliveryStatus
+
+func (d *DeliveryStatusDie) ConditionsDie(conditions ...*diemetav1.ConditionDie) *DeliveryStatusDie {
+	return d.DieStamp(func(r *cartov1alpha1.DeliveryStatus) {
+		r.Conditions = make([]metav1.Condition, len(conditions))
+		for i := range conditions {
+			r.Conditions[i] = conditions[i].DieRelease()
+		}
+	})
+}
+
+var (
+	ClusterDeliveryConditionReadyBlank = diemetav1.ConditionBlank.Type(cartov1alpha1.DeliveryReady)
+)
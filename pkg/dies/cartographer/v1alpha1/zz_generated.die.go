@@ -35,6 +35,400 @@ import (
 	cartographerv1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 )
 
+var ClusterDeliveryBlank = (&ClusterDeliveryDie{}).DieFeed(cartographerv1alpha1.ClusterDelivery{})
+
+type ClusterDeliveryDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       cartographerv1alpha1.ClusterDelivery
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterDeliveryDie) DieImmutable(immutable bool) *ClusterDeliveryDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterDeliveryDie) DieFeed(r cartographerv1alpha1.ClusterDelivery) *ClusterDeliveryDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ClusterDeliveryDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterDeliveryDie) DieFeedPtr(r *cartographerv1alpha1.ClusterDelivery) *ClusterDeliveryDie {
+	if r == nil {
+		r = &cartographerv1alpha1.ClusterDelivery{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterDeliveryDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterDeliveryDie {
+	b, _ := json.Marshal(raw)
+	r := cartographerv1alpha1.ClusterDelivery{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterDeliveryDie) DieRelease() cartographerv1alpha1.ClusterDelivery {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterDeliveryDie) DieReleasePtr() *cartographerv1alpha1.ClusterDelivery {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *ClusterDeliveryDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterDeliveryDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterDeliveryDie) DieStamp(fn func(r *cartographerv1alpha1.ClusterDelivery)) *ClusterDeliveryDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterDeliveryDie) DeepCopy() *ClusterDeliveryDie {
+	r := *d.r.DeepCopy()
+	return &ClusterDeliveryDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+var _ runtime.Object = (*ClusterDeliveryDie)(nil)
+
+func (d *ClusterDeliveryDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ClusterDeliveryDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ClusterDeliveryDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ClusterDeliveryDie) UnmarshalJSON(b []byte) error {
+	if d == ClusterDeliveryBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &cartographerv1alpha1.ClusterDelivery{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ClusterDeliveryDie) APIVersion(v string) *ClusterDeliveryDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.ClusterDelivery) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ClusterDeliveryDie) Kind(v string) *ClusterDeliveryDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.ClusterDelivery) {
+		r.Kind = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ClusterDeliveryDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterDeliveryDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.ClusterDelivery) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ClusterDeliveryDie) SpecDie(fn func(d *DeliverySpecDie)) *ClusterDeliveryDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.ClusterDelivery) {
+		d := DeliverySpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ClusterDeliveryDie) StatusDie(fn func(d *DeliveryStatusDie)) *ClusterDeliveryDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.ClusterDelivery) {
+		d := DeliveryStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *ClusterDeliveryDie) Spec(v cartographerv1alpha1.DeliverySpec) *ClusterDeliveryDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.ClusterDelivery) {
+		r.Spec = v
+	})
+}
+
+func (d *ClusterDeliveryDie) Status(v cartographerv1alpha1.DeliveryStatus) *ClusterDeliveryDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.ClusterDelivery) {
+		r.Status = v
+	})
+}
+
+var DeliverySpecBlank = (&DeliverySpecDie{}).DieFeed(cartographerv1alpha1.DeliverySpec{})
+
+type DeliverySpecDie struct {
+	mutable bool
+	r       cartographerv1alpha1.DeliverySpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *DeliverySpecDie) DieImmutable(immutable bool) *DeliverySpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *DeliverySpecDie) DieFeed(r cartographerv1alpha1.DeliverySpec) *DeliverySpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &DeliverySpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *DeliverySpecDie) DieFeedPtr(r *cartographerv1alpha1.DeliverySpec) *DeliverySpecDie {
+	if r == nil {
+		r = &cartographerv1alpha1.DeliverySpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *DeliverySpecDie) DieFeedRawExtension(raw runtime.RawExtension) *DeliverySpecDie {
+	b, _ := json.Marshal(raw)
+	r := cartographerv1alpha1.DeliverySpec{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *DeliverySpecDie) DieRelease() cartographerv1alpha1.DeliverySpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *DeliverySpecDie) DieReleasePtr() *cartographerv1alpha1.DeliverySpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *DeliverySpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *DeliverySpecDie) DieStamp(fn func(r *cartographerv1alpha1.DeliverySpec)) *DeliverySpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *DeliverySpecDie) DeepCopy() *DeliverySpecDie {
+	r := *d.r.DeepCopy()
+	return &DeliverySpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+func (d *DeliverySpecDie) Resources(v ...cartographerv1alpha1.DeliveryResource) *DeliverySpecDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.DeliverySpec) {
+		r.Resources = v
+	})
+}
+
+func (d *DeliverySpecDie) Params(v ...cartographerv1alpha1.DelegatableParam) *DeliverySpecDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.DeliverySpec) {
+		r.Params = v
+	})
+}
+
+func (d *DeliverySpecDie) ServiceAccountRef(v cartographerv1alpha1.ServiceAccountRef) *DeliverySpecDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.DeliverySpec) {
+		r.ServiceAccountRef = v
+	})
+}
+
+func (d *DeliverySpecDie) Selector(v map[string]string) *DeliverySpecDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.DeliverySpec) {
+		r.Selector = v
+	})
+}
+
+func (d *DeliverySpecDie) SelectorMatchExpressions(v ...metav1.LabelSelectorRequirement) *DeliverySpecDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.DeliverySpec) {
+		r.SelectorMatchExpressions = v
+	})
+}
+
+func (d *DeliverySpecDie) SelectorMatchFields(v ...cartographerv1alpha1.FieldSelectorRequirement) *DeliverySpecDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.DeliverySpec) {
+		r.SelectorMatchFields = v
+	})
+}
+
+var DeliveryStatusBlank = (&DeliveryStatusDie{}).DieFeed(cartographerv1alpha1.DeliveryStatus{})
+
+type DeliveryStatusDie struct {
+	mutable bool
+	r       cartographerv1alpha1.DeliveryStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *DeliveryStatusDie) DieImmutable(immutable bool) *DeliveryStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *DeliveryStatusDie) DieFeed(r cartographerv1alpha1.DeliveryStatus) *DeliveryStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &DeliveryStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *DeliveryStatusDie) DieFeedPtr(r *cartographerv1alpha1.DeliveryStatus) *DeliveryStatusDie {
+	if r == nil {
+		r = &cartographerv1alpha1.DeliveryStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *DeliveryStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *DeliveryStatusDie {
+	b, _ := json.Marshal(raw)
+	r := cartographerv1alpha1.DeliveryStatus{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *DeliveryStatusDie) DieRelease() cartographerv1alpha1.DeliveryStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *DeliveryStatusDie) DieReleasePtr() *cartographerv1alpha1.DeliveryStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *DeliveryStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *DeliveryStatusDie) DieStamp(fn func(r *cartographerv1alpha1.DeliveryStatus)) *DeliveryStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *DeliveryStatusDie) DeepCopy() *DeliveryStatusDie {
+	r := *d.r.DeepCopy()
+	return &DeliveryStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+func (d *DeliveryStatusDie) Conditions(v ...metav1.Condition) *DeliveryStatusDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.DeliveryStatus) {
+		r.Conditions = v
+	})
+}
+
+func (d *DeliveryStatusDie) ObservedGeneration(v int64) *DeliveryStatusDie {
+	return d.DieStamp(func(r *cartographerv1alpha1.DeliveryStatus) {
+		r.ObservedGeneration = v
+	})
+}
+
 var ClusterSupplyChainBlank = (&ClusterSupplyChainDie{}).DieFeed(cartographerv1alpha1.ClusterSupplyChain{})
 
 type ClusterSupplyChainDie struct {
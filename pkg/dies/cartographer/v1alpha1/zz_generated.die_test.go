@@ -27,6 +27,33 @@ import (
 	testing "dies.dev/testing"
 )
 
+func TestClusterDeliveryDie_MissingMethods(t *testingx.T) {
+	die := ClusterDeliveryBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterDeliveryDie: %s", diff.List())
+	}
+}
+
+func TestDeliverySpecDie_MissingMethods(t *testingx.T) {
+	die := DeliverySpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for DeliverySpecDie: %s", diff.List())
+	}
+}
+
+func TestDeliveryStatusDie_MissingMethods(t *testingx.T) {
+	die := DeliveryStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for DeliveryStatusDie: %s", diff.List())
+	}
+}
+
 func TestClusterSupplyChainDie_MissingMethods(t *testingx.T) {
 	die := ClusterSupplyChainBlank
 	ignore := []string{"TypeMeta", "ObjectMeta"}
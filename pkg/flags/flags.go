@@ -28,17 +28,25 @@ const (
 	BuildEnvFlagName         = "--build-env"
 	ComponentFlagName        = "--component"
 	ConfigFlagName           = "--config"
+	ContainerFlagName        = "--container"
 	ContextFlagName          = cli.ContextFlagName
 	DebugFlagName            = "--debug"
 	DryRunFlagName           = "--dry-run"
 	EnvFlagName              = "--env"
+	EventsLimitFlagName      = "--events-limit"
+	ExcludeFlagName          = "--exclude"
 	ExportFlagName           = "--export"
 	FilePathFlagName         = "--file"
+	FollowFlagName           = "--follow"
+	ForceConflictsFlagName   = "--force-conflicts"
 	GitBranchFlagName        = "--git-branch"
 	GitCommitFlagName        = "--git-commit"
 	GitFlagWildcard          = "--git-*"
 	GitRepoFlagName          = "--git-repo"
 	GitTagFlagName           = "--git-tag"
+	GraphFlagName            = "--graph"
+	GrepFlagName             = "--grep"
+	HistoryLimitFlagName     = "--history-limit"
 	ImageFlagName            = "--image"
 	KubeConfigFlagName       = cli.KubeConfigFlagName
 	LabelFlagName            = "--label"
@@ -53,26 +61,43 @@ const (
 	NamespaceFlagName        = cli.NamespaceFlagName
 	NoColorFlagName          = cli.NoColorFlagName
 	OutputFlagName           = "--output"
+	OutputDirFlagName        = "--output-dir"
+	OutputMaxSizeFlagName    = "--output-max-size"
+	OutputOfFlagName         = "--output-of"
 	ParamFlagName            = "--param"
 	ParamYamlFlagName        = "--param-yaml"
+	PreviousFlagName         = "--previous"
+	ReadyFlagName            = "--ready"
+	RecursiveFlagName        = "--recursive"
 	RegistryCertFlagName     = "--registry-ca-cert"
 	RegistryPasswordFlagName = "--registry-password"
 	RegistryTokenFlagName    = "--registry-token"
 	RegistryUsernameFlagName = "--registry-username"
 	RequestCPUFlagName       = "--request-cpu"
 	RequestMemoryFlagName    = "--request-memory"
+	RevisionFlagName         = "--revision"
+	SelectorFlagName         = "--selector"
+	ServerSideFlagName       = "--server-side"
 	ServiceAccountFlagName   = "--service-account"
 	ServiceRefFlagName       = "--service-ref"
+	ShowTemplateBodyFlagName = "--show-template-body"
+	ShowTemplatesFlagName    = "--show-templates"
 	SinceFlagName            = "--since"
 	SourceImageFlagName      = "--source-image"
+	StepFlagName             = "--step"
+	StrictParamsFlagName     = "--strict-params"
 	SubPathFlagName          = "--sub-path"
+	SupplyChainFlagName      = "--supply-chain"
 	TailFlagName             = "--tail"
 	TimestampFlagName        = "--timestamp"
 	TailTimestampFlagName    = "--tail-timestamp"
+	ToRevisionFlagName       = "--to-revision"
 	TypeFlagName             = "--type"
+	UpdateAttemptsFlagName   = "--update-attempts"
 	UpdateStrategyFlagName   = "--update-strategy"
 	VerboseLevelFlagName     = "--verbose"
 	WaitFlagName             = "--wait"
 	WaitTimeoutFlagName      = "--wait-timeout"
+	WatchFlagName            = "--watch"
 	YesFlagName              = "--yes"
 )
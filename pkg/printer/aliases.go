@@ -24,6 +24,7 @@ type Object = printer.Object
 
 var ExportResource = printer.ExportResource
 var OutputResource = printer.OutputResource
+var OutputObject = printer.OutputObject
 var FindCondition = printer.FindCondition
 var ResourceDiff = printer.ResourceDiff
 var ResourceStatus = printer.ResourceStatus
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"io"
+
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+func ClusterDeliveryPrinter(w io.Writer, clusterdelivery *cartov1alpha1.ClusterDelivery) error {
+	printClusterDeliverySelectors := func(clusterdelivery *cartov1alpha1.ClusterDelivery, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		spec := clusterdelivery.Spec
+		return selectorRows(clusterdelivery, spec.Selector, spec.SelectorMatchFields, spec.SelectorMatchExpressions), nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		h.TableHandler(selectorColumns(), printClusterDeliverySelectors)
+	})
+	return tablePrinter.PrintObj(clusterdelivery, w)
+}
+
+func ClusterDeliveryResourcesPrinter(w io.Writer, clusterdelivery *cartov1alpha1.ClusterDelivery) error {
+	printResources := func(clusterdelivery *cartov1alpha1.ClusterDelivery, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(clusterdelivery.Spec.Resources))
+		for _, resource := range clusterdelivery.Spec.Resources {
+			rows = append(rows, metav1beta1.TableRow{
+				Cells: []interface{}{
+					resource.Name,
+					resource.TemplateRef.Kind,
+					resource.TemplateRef.Name,
+				},
+			})
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Template Kind", Type: "string"},
+			{Name: "Template Name", Type: "string"},
+		}
+		h.TableHandler(columns, printResources)
+	})
+	return tablePrinter.PrintObj(clusterdelivery, w)
+}
@@ -17,14 +17,20 @@ limitations under the License.
 package printer
 
 import (
+	"encoding/json"
+	"fmt"
 	"io"
 	"sort"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
 
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
 )
 
@@ -35,9 +41,158 @@ const (
 )
 
 func ClusterSupplyChainPrinter(w io.Writer, clustersupplychain *cartov1alpha1.ClusterSupplyChain) error {
+	printClusterSupplyChainSelectors := func(clustersupplychain *cartov1alpha1.ClusterSupplyChain, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		spec := clustersupplychain.Spec
+		return selectorRows(clustersupplychain, spec.Selector, spec.SelectorMatchFields, spec.SelectorMatchExpressions), nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		h.TableHandler(selectorColumns(), printClusterSupplyChainSelectors)
+	})
+	return tablePrinter.PrintObj(clustersupplychain, w)
+}
+
+// ClusterSupplyChainTemplatesPrinter prints the template of each resource of the supply chain with
+// its Ready status. Templates are looked up by resource name, a nil template is missing from the
+// cluster.
+func ClusterSupplyChainTemplatesPrinter(w io.Writer, clustersupplychain *cartov1alpha1.ClusterSupplyChain, templates map[string]*unstructured.Unstructured) error {
+	printTemplates := func(clustersupplychain *cartov1alpha1.ClusterSupplyChain, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(clustersupplychain.Spec.Resources))
+		for _, resource := range clustersupplychain.Spec.Resources {
+			status := printer.Serrorf("not found")
+			if template := templates[resource.Name]; template != nil {
+				status = printer.ConditionStatus(printer.FindCondition(templateConditions(template), cartov1alpha1.ConditionReady))
+			}
+			rows = append(rows, metav1beta1.TableRow{
+				Cells: []interface{}{
+					resource.Name,
+					resource.TemplateRef.Kind,
+					resource.TemplateRef.Name,
+					status,
+				},
+			})
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Template Kind", Type: "string"},
+			{Name: "Template Name", Type: "string"},
+			{Name: "Ready", Type: "string"},
+		}
+		h.TableHandler(columns, printTemplates)
+	})
+	return tablePrinter.PrintObj(clustersupplychain, w)
+}
+
+// ClusterSupplyChainTemplateParamsPrinter prints the params each template of the supply chain
+// declares, along with their default value.
+func ClusterSupplyChainTemplateParamsPrinter(w io.Writer, clustersupplychain *cartov1alpha1.ClusterSupplyChain, templates map[string]*unstructured.Unstructured) error {
+	printParams := func(clustersupplychain *cartov1alpha1.ClusterSupplyChain, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := []metav1beta1.TableRow{}
+		for _, resource := range clustersupplychain.Spec.Resources {
+			template := templates[resource.Name]
+			if template == nil {
+				continue
+			}
+			params, _, _ := unstructured.NestedSlice(template.Object, "spec", "params")
+			for _, p := range params {
+				param, ok := p.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, _, _ := unstructured.NestedString(param, "name")
+				value := ""
+				if d, found := param["default"]; found {
+					b, err := json.Marshal(d)
+					if err != nil {
+						return nil, err
+					}
+					value = string(b)
+				}
+				rows = append(rows, metav1beta1.TableRow{
+					Cells: []interface{}{
+						resource.Name,
+						name,
+						printer.EmptyString(value),
+					},
+				})
+			}
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Resource", Type: "string"},
+			{Name: "Name", Type: "string"},
+			{Name: "Default", Type: "string"},
+		}
+		h.TableHandler(columns, printParams)
+	})
+	return tablePrinter.PrintObj(clustersupplychain, w)
+}
+
+// TemplateBodyPrinter prints the body of the template, either the ytt source or the template
+// object as yaml.
+func TemplateBodyPrinter(w io.Writer, template *unstructured.Unstructured) error {
+	body := ""
+	if ytt, found, _ := unstructured.NestedString(template.Object, "spec", "ytt"); found {
+		body = ytt
+	} else if obj, found, _ := unstructured.NestedMap(template.Object, "spec", "template"); found {
+		b, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		body = string(b)
+	}
+	if strings.TrimSpace(body) == "" {
+		_, err := fmt.Fprintln(w, AddPaddingStart(printer.Sfaintf("<empty>")))
+		return err
+	}
+	for _, line := range strings.Split(strings.TrimRight(body, "\n"), "\n") {
+		if _, err := fmt.Fprintln(w, AddPaddingStart(line)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// templateConditions reads the status conditions of a template, if there are any.
+func templateConditions(template *unstructured.Unstructured) []metav1.Condition {
+	conditions := []metav1.Condition{}
+	items, _, _ := unstructured.NestedSlice(template.Object, "status", "conditions")
+	for _, item := range items {
+		u, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		condition := metav1.Condition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, &condition); err != nil {
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+func selectorColumns() []metav1beta1.TableColumnDefinition {
+	return []metav1beta1.TableColumnDefinition{
+		{Name: "Type", Type: "string"},
+		{Name: "Key", Type: "string"},
+		{Name: "Operator", Type: "string"},
+		{Name: "Value", Type: "string"},
+	}
+}
+
+// selectorRows returns a row for each label, field and expression a resource must match to be
+// selected by a blueprint, like a cluster supply chain or a cluster delivery.
+func selectorRows(obj runtime.Object, selector map[string]string, fields []cartov1alpha1.FieldSelectorRequirement, expressions []metav1.LabelSelectorRequirement) []metav1beta1.TableRow {
 	printRow := func(typeStr, key, operator string, values ...string) metav1beta1.TableRow {
 		row := metav1beta1.TableRow{
-			Object: runtime.RawExtension{Object: clustersupplychain},
+			Object: runtime.RawExtension{Object: obj},
 		}
 		row.Cells = append(row.Cells,
 			typeStr,
@@ -50,85 +205,33 @@ func ClusterSupplyChainPrinter(w io.Writer, clustersupplychain *cartov1alpha1.Cl
 		}
 		return row
 	}
-	printSelectorRow := func(selectors map[string]string, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
-		if len(selectors) == 0 {
-			return nil, nil
-		}
-		keys := make([]string, 0, len(selectors))
-		for k := range selectors {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
-		selectorRows := []metav1beta1.TableRow{}
-		for _, k := range keys {
-			selectorRows = append(selectorRows, printRow(SelectorType, k, "", selectors[k]))
-		}
-		return selectorRows, nil
+
+	rows := []metav1beta1.TableRow{}
+	keys := make([]string, 0, len(selector))
+	for k := range selector {
+		keys = append(keys, k)
 	}
-	printMatchExpressionsRows := func(expressions []metav1.LabelSelectorRequirement, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
-		if len(expressions) == 0 {
-			return nil, nil
-		}
-		expressionRows := []metav1beta1.TableRow{}
-		for i := range expressions {
-			if len(expressions[i].Values) == 0 {
-				expressionRows = append(expressionRows, printRow(MatchExpressionType, expressions[i].Key, string(expressions[i].Operator)))
-			} else {
-				for j := range expressions[i].Values {
-					expressionRows = append(expressionRows, printRow(MatchExpressionType, expressions[i].Key, string(expressions[i].Operator), expressions[i].Values[j]))
-				}
-			}
-		}
-		return expressionRows, nil
+	sort.Strings(keys)
+	for _, k := range keys {
+		rows = append(rows, printRow(SelectorType, k, "", selector[k]))
 	}
-	printMatchFieldsRow := func(fields []cartov1alpha1.FieldSelectorRequirement, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
-		if len(fields) == 0 {
-			return nil, nil
-		}
-		expressionRows := []metav1beta1.TableRow{}
-		for i := range fields {
-			if len(fields[i].Values) == 0 {
-				expressionRows = append(expressionRows, printRow(MatchFieldType, fields[i].Key, string(fields[i].Operator)))
-			} else {
-				for j := range fields[i].Values {
-					expressionRows = append(expressionRows, printRow(MatchFieldType, fields[i].Key, string(fields[i].Operator), fields[i].Values[j]))
-				}
+	for i := range fields {
+		if len(fields[i].Values) == 0 {
+			rows = append(rows, printRow(MatchFieldType, fields[i].Key, string(fields[i].Operator)))
+		} else {
+			for j := range fields[i].Values {
+				rows = append(rows, printRow(MatchFieldType, fields[i].Key, string(fields[i].Operator), fields[i].Values[j]))
 			}
 		}
-		return expressionRows, nil
 	}
-
-	printClusterSupplyChainSelectors := func(clustersupplychain *cartov1alpha1.ClusterSupplyChain, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
-		rows := []metav1beta1.TableRow{}
-		selectorRows, err := printSelectorRow(clustersupplychain.Spec.Selector, printOpts)
-		if err != nil {
-			return nil, err
-		}
-		rows = append(rows, selectorRows...)
-		fieldRows, err := printMatchFieldsRow(clustersupplychain.Spec.SelectorMatchFields, printOpts)
-		if err != nil {
-			return nil, err
-		}
-		rows = append(rows, fieldRows...)
-		expressionRows, err := printMatchExpressionsRows(clustersupplychain.Spec.SelectorMatchExpressions, printOpts)
-		if err != nil {
-			return nil, err
+	for i := range expressions {
+		if len(expressions[i].Values) == 0 {
+			rows = append(rows, printRow(MatchExpressionType, expressions[i].Key, string(expressions[i].Operator)))
+		} else {
+			for j := range expressions[i].Values {
+				rows = append(rows, printRow(MatchExpressionType, expressions[i].Key, string(expressions[i].Operator), expressions[i].Values[j]))
+			}
 		}
-		rows = append(rows, expressionRows...)
-		return rows, nil
 	}
-
-	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
-		columns := []metav1beta1.TableColumnDefinition{
-			{Name: "Type", Type: "string"},
-			{Name: "Key", Type: "string"},
-			{Name: "Operator", Type: "string"},
-			{Name: "Value", Type: "string"},
-		}
-		h.TableHandler(columns, printSelectorRow)
-		h.TableHandler(columns, printMatchFieldsRow)
-		h.TableHandler(columns, printMatchExpressionsRows)
-		h.TableHandler(columns, printClusterSupplyChainSelectors)
-	})
-	return tablePrinter.PrintObj(clustersupplychain, w)
+	return rows
 }
@@ -23,6 +23,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
@@ -164,3 +165,67 @@ func TestClusterSupplyChainPrinter(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplateBodyPrinter(t *testing.T) {
+	tests := []struct {
+		name           string
+		template       *unstructured.Unstructured
+		expectedOutput string
+	}{{
+		name: "template",
+		template: &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"apiVersion": "kpack.io/v1alpha2",
+						"kind":       "Image",
+						"metadata": map[string]interface{}{
+							"name": "$(workload.metadata.name)$",
+						},
+					},
+				},
+			},
+		},
+		expectedOutput: `
+   apiVersion: kpack.io/v1alpha2
+   kind: Image
+   metadata:
+     name: $(workload.metadata.name)$
+`,
+	}, {
+		name: "ytt",
+		template: &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"ytt": "#@ load(\"@ytt:data\", \"data\")\n---\napiVersion: v1\n",
+				},
+			},
+		},
+		expectedOutput: `
+   #@ load("@ytt:data", "data")
+   ---
+   apiVersion: v1
+`,
+	}, {
+		name: "empty",
+		template: &unstructured.Unstructured{
+			Object: map[string]interface{}{},
+		},
+		expectedOutput: `
+   <empty>
+`,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			if err := printer.TemplateBodyPrinter(output, test.template); err != nil {
+				t.Errorf("TemplateBodyPrinter() expected no error, got %v", err)
+			}
+
+			outputString := output.String()
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), outputString); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
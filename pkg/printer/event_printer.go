@@ -0,0 +1,85 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+// EventTime returns the last time the event was seen, falling back to the fields set by clients
+// that do not fill in the last timestamp.
+func EventTime(event *corev1.Event) metav1.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp
+	case !event.EventTime.IsZero():
+		return metav1.Time{Time: event.EventTime.Time}
+	case !event.FirstTimestamp.IsZero():
+		return event.FirstTimestamp
+	}
+	return event.CreationTimestamp
+}
+
+// EventsPrinter prints the events with the object each of them is about, in the order of the list.
+func EventsPrinter(w io.Writer, events *corev1.EventList) error {
+	now := time.Now()
+	printEvent := func(event *corev1.Event, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		row := metav1beta1.TableRow{
+			Cells: []interface{}{
+				printer.TimestampSince(EventTime(event), now),
+				event.Type,
+				printer.EmptyString(event.Reason),
+				fmt.Sprintf("%s/%s", event.InvolvedObject.Kind, event.InvolvedObject.Name),
+				printer.EmptyString(event.Message),
+			},
+		}
+		return []metav1beta1.TableRow{row}, nil
+	}
+	printEventList := func(events *corev1.EventList, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(events.Items))
+		for i := range events.Items {
+			r, err := printEvent(&events.Items[i], printOpts)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, r...)
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Last Seen", Type: "string"},
+			{Name: "Type", Type: "string"},
+			{Name: "Reason", Type: "string"},
+			{Name: "Object", Type: "string"},
+			{Name: "Message", Type: "string"},
+		}
+		h.TableHandler(columns, printEventList)
+		h.TableHandler(columns, printEvent)
+	})
+	return tablePrinter.PrintObj(events, w)
+}
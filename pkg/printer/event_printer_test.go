@@ -0,0 +1,94 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestEventTime(t *testing.T) {
+	first := metav1.NewTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	last := metav1.NewTime(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC))
+	micro := metav1.NewMicroTime(time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC))
+	created := metav1.NewTime(time.Date(2023, 1, 4, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name  string
+		event *corev1.Event
+		want  metav1.Time
+	}{{
+		name:  "last timestamp",
+		event: &corev1.Event{FirstTimestamp: first, LastTimestamp: last, EventTime: micro},
+		want:  last,
+	}, {
+		name:  "event time",
+		event: &corev1.Event{FirstTimestamp: first, EventTime: micro},
+		want:  metav1.Time{Time: micro.Time},
+	}, {
+		name:  "first timestamp",
+		event: &corev1.Event{FirstTimestamp: first},
+		want:  first,
+	}, {
+		name:  "creation timestamp",
+		event: &corev1.Event{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created}},
+		want:  created,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := printer.EventTime(test.event); !got.Equal(&test.want) {
+				t.Errorf("EventTime() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEventsPrinter(t *testing.T) {
+	events := &corev1.EventList{
+		Items: []corev1.Event{{
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "my-workload-00001-deployment"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedScheduling",
+			Message:        "0/3 nodes are available: 3 Insufficient cpu.",
+			LastTimestamp:  metav1.NewTime(time.Now().AddDate(-10, 0, 0)),
+		}, {
+			InvolvedObject: corev1.ObjectReference{Kind: "Workload", Name: "my-workload"},
+			Type:           corev1.EventTypeNormal,
+		}},
+	}
+	expectedOutput := `
+   LAST SEEN   TYPE      REASON             OBJECT                             MESSAGE
+   10y         Warning   FailedScheduling   Pod/my-workload-00001-deployment   0/3 nodes are available: 3 Insufficient cpu.
+   <unknown>   Normal    <empty>            Workload/my-workload               <empty>
+`
+
+	output := &bytes.Buffer{}
+	if err := printer.EventsPrinter(output, events); err != nil {
+		t.Errorf("EventsPrinter() expected no error, got %v", err)
+	}
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}
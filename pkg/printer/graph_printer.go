@@ -0,0 +1,228 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+)
+
+const (
+	GraphFormatASCII   = "ascii"
+	GraphFormatDot     = "dot"
+	GraphFormatMermaid = "mermaid"
+)
+
+const (
+	graphNodeReady    = "ready"
+	graphNodeNotReady = "notready"
+	graphNodeUnknown  = "unknown"
+)
+
+var graphNodeColors = map[string]string{
+	graphNodeReady:    "#a6e3a1",
+	graphNodeNotReady: "#f38ba8",
+	graphNodeUnknown:  "#d3d3d3",
+}
+
+// GraphNode is a resource of a blueprint, Inputs are the names of the resources it consumes the
+// outputs of.
+type GraphNode struct {
+	Name   string
+	Inputs []string
+	Ready  *metav1.Condition
+}
+
+// ClusterSupplyChainGraph returns the resources of the supply chain, linked by the sources, images
+// and configs each of them consumes. The supply chain is not realized, so the nodes have no Ready
+// condition.
+func ClusterSupplyChainGraph(clustersupplychain *cartov1alpha1.ClusterSupplyChain) []GraphNode {
+	nodes := make([]GraphNode, 0, len(clustersupplychain.Spec.Resources))
+	for _, resource := range clustersupplychain.Spec.Resources {
+		node := GraphNode{Name: resource.Name}
+		for _, refs := range [][]cartov1alpha1.ResourceReference{resource.Sources, resource.Images, resource.Configs} {
+			for _, ref := range refs {
+				node.Inputs = append(node.Inputs, ref.Resource)
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// WorkloadGraph returns the resources realized for the workload, linked by their inputs, along with
+// their Ready condition.
+func WorkloadGraph(workload *cartov1alpha1.Workload) []GraphNode {
+	nodes := make([]GraphNode, 0, len(workload.Status.Resources))
+	for _, resource := range workload.Status.Resources {
+		node := GraphNode{
+			Name:  resource.Name,
+			Ready: printer.FindCondition(resource.Conditions, cartov1alpha1.ConditionResourceReady),
+		}
+		for _, input := range resource.Inputs {
+			node.Inputs = append(node.Inputs, input.Name)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// GraphPrinter prints the nodes as a graph, either as an ascii tree for the terminal, as a DOT
+// digraph or as a Mermaid flowchart.
+func GraphPrinter(w io.Writer, format, name string, nodes []GraphNode) error {
+	switch format {
+	case GraphFormatDot:
+		return graphDotPrinter(w, name, nodes)
+	case GraphFormatMermaid:
+		return graphMermaidPrinter(w, nodes)
+	case GraphFormatASCII:
+		return graphASCIIPrinter(w, nodes)
+	default:
+		return fmt.Errorf("unsupported graph format %q", format)
+	}
+}
+
+func graphDotPrinter(w io.Writer, name string, nodes []GraphNode) error {
+	b := strings.Builder{}
+	b.WriteString(fmt.Sprintf("digraph %s {\n", strconv.Quote(name)))
+	b.WriteString("  rankdir=\"LR\";\n")
+	b.WriteString("  node [shape=\"box\", style=\"rounded,filled\"];\n")
+	for _, node := range nodes {
+		b.WriteString(fmt.Sprintf("  %s [fillcolor=%q];\n", strconv.Quote(node.Name), graphNodeColors[graphNodeStatus(node)]))
+	}
+	for _, edge := range graphEdges(nodes) {
+		b.WriteString(fmt.Sprintf("  %s -> %s;\n", strconv.Quote(edge[0]), strconv.Quote(edge[1])))
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func graphMermaidPrinter(w io.Writer, nodes []GraphNode) error {
+	// resource names are not valid mermaid ids, e.g. "source-provider" would read as an edge
+	ids := make(map[string]string, len(nodes))
+	for i, node := range nodes {
+		ids[node.Name] = fmt.Sprintf("n%d", i)
+	}
+
+	b := strings.Builder{}
+	b.WriteString("flowchart LR\n")
+	for _, node := range nodes {
+		b.WriteString(fmt.Sprintf("  %s[%q]\n", ids[node.Name], node.Name))
+	}
+	for _, edge := range graphEdges(nodes) {
+		b.WriteString(fmt.Sprintf("  %s --> %s\n", ids[edge[0]], ids[edge[1]]))
+	}
+	for _, status := range []string{graphNodeReady, graphNodeNotReady, graphNodeUnknown} {
+		b.WriteString(fmt.Sprintf("  classDef %s fill:%s\n", status, graphNodeColors[status]))
+	}
+	for _, node := range nodes {
+		b.WriteString(fmt.Sprintf("  class %s %s\n", ids[node.Name], graphNodeStatus(node)))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// graphASCIIPrinter prints a tree from each node without inputs to the nodes consuming its
+// outputs. A node with more than one input is only expanded the first time it is printed.
+func graphASCIIPrinter(w io.Writer, nodes []GraphNode) error {
+	byName := make(map[string]GraphNode, len(nodes))
+	for _, node := range nodes {
+		byName[node.Name] = node
+	}
+	consumers := map[string][]string{}
+	hasInputs := map[string]bool{}
+	for _, edge := range graphEdges(nodes) {
+		consumers[edge[0]] = append(consumers[edge[0]], edge[1])
+		hasInputs[edge[1]] = true
+	}
+
+	b := strings.Builder{}
+	printed := map[string]bool{}
+	var printNode func(name, prefix, branch, childPrefix string)
+	printNode = func(name, prefix, branch, childPrefix string) {
+		line := fmt.Sprintf("%s%s%s (%s)", prefix, branch, name, printer.ConditionStatus(byName[name].Ready))
+		if printed[name] {
+			b.WriteString(line + printer.Sfaintf(" ...") + "\n")
+			return
+		}
+		printed[name] = true
+		b.WriteString(line + "\n")
+		for i, consumer := range consumers[name] {
+			if i == len(consumers[name])-1 {
+				printNode(consumer, prefix+childPrefix, "└── ", "    ")
+			} else {
+				printNode(consumer, prefix+childPrefix, "├── ", "│   ")
+			}
+		}
+	}
+	for _, node := range nodes {
+		if !hasInputs[node.Name] {
+			printNode(node.Name, strings.Repeat(" ", paddingStart), "", "")
+		}
+	}
+	// nodes in a cycle have no root to be reached from
+	for _, node := range nodes {
+		if !printed[node.Name] {
+			printNode(node.Name, strings.Repeat(" ", paddingStart), "", "")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// graphEdges returns the edges from each input to the node consuming it, inputs that are not part
+// of the nodes are ignored.
+func graphEdges(nodes []GraphNode) [][2]string {
+	known := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		known[node.Name] = true
+	}
+	edges := [][2]string{}
+	for _, node := range nodes {
+		seen := map[string]bool{}
+		for _, input := range node.Inputs {
+			if !known[input] || seen[input] {
+				continue
+			}
+			seen[input] = true
+			edges = append(edges, [2]string{input, node.Name})
+		}
+	}
+	return edges
+}
+
+func graphNodeStatus(node GraphNode) string {
+	if node.Ready == nil {
+		return graphNodeUnknown
+	}
+	switch node.Ready.Status {
+	case metav1.ConditionTrue:
+		return graphNodeReady
+	case metav1.ConditionFalse:
+		return graphNodeNotReady
+	default:
+		return graphNodeUnknown
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestClusterSupplyChainGraph(t *testing.T) {
+	supplyChain := &cartov1alpha1.ClusterSupplyChain{
+		Spec: cartov1alpha1.SupplyChainSpec{
+			Resources: []cartov1alpha1.SupplyChainResource{{
+				Name: "source-provider",
+			}, {
+				Name:    "image-builder",
+				Sources: []cartov1alpha1.ResourceReference{{Name: "source", Resource: "source-provider"}},
+			}, {
+				Name:    "config-provider",
+				Images:  []cartov1alpha1.ResourceReference{{Name: "image", Resource: "image-builder"}},
+				Sources: []cartov1alpha1.ResourceReference{{Name: "source", Resource: "source-provider"}},
+			}, {
+				Name:    "deliverable",
+				Configs: []cartov1alpha1.ResourceReference{{Name: "config", Resource: "config-provider"}},
+			}},
+		},
+	}
+	expected := []printer.GraphNode{
+		{Name: "source-provider"},
+		{Name: "image-builder", Inputs: []string{"source-provider"}},
+		{Name: "config-provider", Inputs: []string{"source-provider", "image-builder"}},
+		{Name: "deliverable", Inputs: []string{"config-provider"}},
+	}
+	if diff := cmp.Diff(expected, printer.ClusterSupplyChainGraph(supplyChain)); diff != "" {
+		t.Errorf("ClusterSupplyChainGraph() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestWorkloadGraph(t *testing.T) {
+	ready := metav1.Condition{Type: cartov1alpha1.ConditionResourceReady, Status: metav1.ConditionTrue}
+	workload := &cartov1alpha1.Workload{
+		Status: cartov1alpha1.WorkloadStatus{
+			Resources: []cartov1alpha1.RealizedResource{{
+				Name:       "source-provider",
+				Conditions: []metav1.Condition{ready},
+			}, {
+				Name:   "image-builder",
+				Inputs: []cartov1alpha1.Input{{Name: "source-provider"}},
+			}},
+		},
+	}
+	expected := []printer.GraphNode{
+		{Name: "source-provider", Ready: &ready},
+		{Name: "image-builder", Inputs: []string{"source-provider"}},
+	}
+	if diff := cmp.Diff(expected, printer.WorkloadGraph(workload)); diff != "" {
+		t.Errorf("WorkloadGraph() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestGraphPrinter(t *testing.T) {
+	nodes := []printer.GraphNode{{
+		Name:  "source-provider",
+		Ready: &metav1.Condition{Type: cartov1alpha1.ConditionResourceReady, Status: metav1.ConditionTrue},
+	}, {
+		Name:   "image-builder",
+		Inputs: []string{"source-provider"},
+		Ready:  &metav1.Condition{Type: cartov1alpha1.ConditionResourceReady, Status: metav1.ConditionFalse, Reason: "Failed"},
+	}, {
+		Name:   "config-provider",
+		Inputs: []string{"source-provider", "image-builder", "missing"},
+	}, {
+		Name:   "deliverable",
+		Inputs: []string{"config-provider"},
+	}}
+
+	tests := []struct {
+		name           string
+		format         string
+		expectedOutput string
+		shouldError    bool
+	}{{
+		name:   "dot",
+		format: printer.GraphFormatDot,
+		expectedOutput: `
+digraph "my-supply-chain" {
+  rankdir="LR";
+  node [shape="box", style="rounded,filled"];
+  "source-provider" [fillcolor="#a6e3a1"];
+  "image-builder" [fillcolor="#f38ba8"];
+  "config-provider" [fillcolor="#d3d3d3"];
+  "deliverable" [fillcolor="#d3d3d3"];
+  "source-provider" -> "image-builder";
+  "source-provider" -> "config-provider";
+  "image-builder" -> "config-provider";
+  "config-provider" -> "deliverable";
+}
+`,
+	}, {
+		name:   "mermaid",
+		format: printer.GraphFormatMermaid,
+		expectedOutput: `
+flowchart LR
+  n0["source-provider"]
+  n1["image-builder"]
+  n2["config-provider"]
+  n3["deliverable"]
+  n0 --> n1
+  n0 --> n2
+  n1 --> n2
+  n2 --> n3
+  classDef ready fill:#a6e3a1
+  classDef notready fill:#f38ba8
+  classDef unknown fill:#d3d3d3
+  class n0 ready
+  class n1 notready
+  class n2 unknown
+  class n3 unknown
+`,
+	}, {
+		name:   "ascii",
+		format: printer.GraphFormatASCII,
+		expectedOutput: `
+   source-provider (Ready)
+   ├── image-builder (Failed)
+   │   └── config-provider (<unknown>)
+   │       └── deliverable (<unknown>)
+   └── config-provider (<unknown>) ...
+`,
+	}, {
+		name:        "unknown format",
+		format:      "svg",
+		shouldError: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			err := printer.GraphPrinter(output, test.format, "my-supply-chain", nodes)
+			if (err != nil) != test.shouldError {
+				t.Fatalf("GraphPrinter() shouldError %v, got %v", test.shouldError, err)
+			}
+			if test.shouldError {
+				return
+			}
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), output.String()); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -23,11 +23,52 @@ import (
 
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
 )
 
+func DeliverableOverviewPrinter(w io.Writer, deliverable *cartov1alpha1.Deliverable) error {
+	printDeliverableOverview := func(deliverable *cartov1alpha1.Deliverable, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		labels := deliverable.Labels
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		rows := []metav1beta1.TableRow{
+			{Cells: []interface{}{"name:", deliverable.GetName()}},
+			{Cells: []interface{}{"type:", printer.EmptyString(labels[apis.WorkloadTypeLabelName])}},
+			{Cells: []interface{}{"namespace:", deliverable.GetNamespace()}},
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{NoHeaders: true, PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		h.TableHandler(nil, printDeliverableOverview)
+	})
+
+	return tablePrinter.PrintObj(deliverable, w)
+}
+
+// DeliverableSourcePrinter prints the git repository or the image the deliverable is delivered
+// from, in the same form as the source of a workload.
+func DeliverableSourcePrinter(w io.Writer, deliverable *cartov1alpha1.Deliverable) error {
+	source := deliverable.Spec.Source
+	if source == nil {
+		return nil
+	}
+	workload := &cartov1alpha1.Workload{
+		Spec: cartov1alpha1.WorkloadSpec{Source: source},
+	}
+	if source.Git != nil {
+		return WorkloadSourceGitPrinter(w, workload)
+	}
+	if source.Image != "" {
+		return WorkloadLocalSourceImagePrinter(w, workload)
+	}
+	return nil
+}
+
 func DeliverableResourcesPrinter(w io.Writer, deliverable *cartov1alpha1.Deliverable) error {
 	printResourceInfoRow := func(resource *cartov1alpha1.RealizedResource, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
 		var healthy string
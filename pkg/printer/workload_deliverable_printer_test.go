@@ -25,10 +25,139 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
 )
 
+func TestDeliverableOverviewPrinter(t *testing.T) {
+	defaultNamespace := "default"
+	deliverableName := "my-deliverable"
+
+	tests := []struct {
+		name            string
+		testDeliverable *cartov1alpha1.Deliverable
+		expectedOutput  string
+	}{{
+		name: "type label not present",
+		testDeliverable: &cartov1alpha1.Deliverable{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      deliverableName,
+				Namespace: defaultNamespace,
+			},
+		},
+		expectedOutput: `
+   name:        my-deliverable
+   type:        <empty>
+   namespace:   default
+`,
+	}, {
+		name: "type label present",
+		testDeliverable: &cartov1alpha1.Deliverable{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      deliverableName,
+				Namespace: "my-namespace",
+				Labels: map[string]string{
+					apis.WorkloadTypeLabelName: "web",
+				},
+			},
+		},
+		expectedOutput: `
+   name:        my-deliverable
+   type:        web
+   namespace:   my-namespace
+`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			if err := printer.DeliverableOverviewPrinter(output, test.testDeliverable); err != nil {
+				t.Errorf("DeliverableOverviewPrinter() expected no error, got %v", err)
+			}
+			outputString := output.String()
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), outputString); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDeliverableSourcePrinter(t *testing.T) {
+	defaultNamespace := "default"
+	deliverableName := "my-deliverable"
+
+	tests := []struct {
+		name            string
+		testDeliverable *cartov1alpha1.Deliverable
+		expectedOutput  string
+	}{{
+		name: "git source",
+		testDeliverable: &cartov1alpha1.Deliverable{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      deliverableName,
+				Namespace: defaultNamespace,
+			},
+			Spec: cartov1alpha1.DeliverableSpec{
+				Source: &cartov1alpha1.Source{
+					Git: &cartov1alpha1.GitSource{
+						URL: "https://github.com/example/my-deliverable-config",
+						Ref: cartov1alpha1.GitRef{
+							Branch: "main",
+						},
+					},
+					Subpath: "config",
+				},
+			},
+		},
+		expectedOutput: `
+   type:       git
+   url:        https://github.com/example/my-deliverable-config
+   sub-path:   config
+   branch:     main
+`,
+	}, {
+		name: "image source",
+		testDeliverable: &cartov1alpha1.Deliverable{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      deliverableName,
+				Namespace: defaultNamespace,
+			},
+			Spec: cartov1alpha1.DeliverableSpec{
+				Source: &cartov1alpha1.Source{
+					Image: "registry.example/my-deliverable-bundle:latest",
+				},
+			},
+		},
+		expectedOutput: `
+   type:    source image
+   image:   registry.example/my-deliverable-bundle:latest
+`,
+	}, {
+		name: "no source",
+		testDeliverable: &cartov1alpha1.Deliverable{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      deliverableName,
+				Namespace: defaultNamespace,
+			},
+		},
+		expectedOutput: ``,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			if err := printer.DeliverableSourcePrinter(output, test.testDeliverable); err != nil {
+				t.Errorf("DeliverableSourcePrinter() expected no error, got %v", err)
+			}
+			outputString := output.String()
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), outputString); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
 func TestDeliverableResourcesPrinter(t *testing.T) {
 	defaultNamespace := "default"
 	deliverableName := "my-deliverable"
@@ -0,0 +1,78 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+// ExplainedObject is one of the objects walked to explain why a resource of the workload is not
+// ready, along with the condition that tells its state.
+type ExplainedObject struct {
+	Kind      string
+	Name      string
+	Condition *metav1.Condition
+	// Message replaces the message of the condition, for objects that could not be read
+	Message string
+}
+
+// WorkloadExplainPrinter prints the objects walked from the failing resource of the workload down
+// to the object that reports the failure.
+func WorkloadExplainPrinter(w io.Writer, workload *cartov1alpha1.Workload, objects []ExplainedObject) error {
+	printObjects := func(_ *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(objects))
+		for _, object := range objects {
+			conditionType, status, reason, message := "", "", "", object.Message
+			if cond := object.Condition; cond != nil {
+				conditionType, status, reason = cond.Type, string(cond.Status), cond.Reason
+				if message == "" {
+					message = cond.Message
+				}
+			}
+			rows = append(rows, metav1beta1.TableRow{
+				Cells: []interface{}{
+					fmt.Sprintf("%s/%s", object.Kind, object.Name),
+					printer.EmptyString(conditionType),
+					printer.EmptyString(status),
+					printer.EmptyString(reason),
+					printer.EmptyString(message),
+				},
+			})
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Object", Type: "string"},
+			{Name: "Condition", Type: "string"},
+			{Name: "Status", Type: "string"},
+			{Name: "Reason", Type: "string"},
+			{Name: "Message", Type: "string"},
+		}
+		h.TableHandler(columns, printObjects)
+	})
+	return tablePrinter.PrintObj(workload, w)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestWorkloadExplainPrinter(t *testing.T) {
+	workload := &cartov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-workload",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		objects        []printer.ExplainedObject
+		expectedOutput string
+	}{{
+		name: "conditions",
+		objects: []printer.ExplainedObject{{
+			Kind: "Resource",
+			Name: "image-provider",
+			Condition: &metav1.Condition{
+				Type:    cartov1alpha1.ConditionResourceReady,
+				Status:  metav1.ConditionFalse,
+				Reason:  "HealthyConditionRule",
+				Message: "build failed",
+			},
+		}, {
+			Kind: "Build",
+			Name: "my-workload-build-1",
+			Condition: &metav1.Condition{
+				Type:    "Succeeded",
+				Status:  metav1.ConditionFalse,
+				Message: "Build pod failed",
+			},
+		}},
+		expectedOutput: `
+   OBJECT                      CONDITION   STATUS   REASON                 MESSAGE
+   Resource/image-provider     Ready       False    HealthyConditionRule   build failed
+   Build/my-workload-build-1   Succeeded   False    <empty>                Build pod failed
+`,
+	}, {
+		name: "object not found",
+		objects: []printer.ExplainedObject{{
+			Kind:    "Image",
+			Name:    "my-workload",
+			Message: "not found",
+		}},
+		expectedOutput: `
+   OBJECT              CONDITION   STATUS    REASON    MESSAGE
+   Image/my-workload   <empty>     <empty>   <empty>   not found
+`,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			if err := printer.WorkloadExplainPrinter(output, workload, test.objects); err != nil {
+				t.Errorf("WorkloadExplainPrinter() expected no error, got %v", err)
+			}
+
+			outputString := output.String()
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), outputString); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
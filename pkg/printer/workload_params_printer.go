@@ -0,0 +1,61 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"io"
+
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+// WorkloadParamsPrinter prints the value each template of the supply chain gets for the params of
+// the workload, and where the value comes from.
+func WorkloadParamsPrinter(w io.Writer, workload *cartov1alpha1.Workload, params []cartov1alpha1.EffectiveParam) error {
+	printParams := func(_ *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(params))
+		for _, param := range params {
+			value := ""
+			if param.Value != nil {
+				value = string(param.Value.Raw)
+			}
+			rows = append(rows, metav1beta1.TableRow{
+				Cells: []interface{}{
+					param.Resource,
+					param.Name,
+					printer.EmptyString(value),
+					param.Source,
+				},
+			})
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Resource", Type: "string"},
+			{Name: "Param", Type: "string"},
+			{Name: "Value", Type: "string"},
+			{Name: "Source", Type: "string"},
+		}
+		h.TableHandler(columns, printParams)
+	})
+	return tablePrinter.PrintObj(workload, w)
+}
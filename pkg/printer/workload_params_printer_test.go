@@ -0,0 +1,80 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestWorkloadParamsPrinter(t *testing.T) {
+	workload := &cartov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-workload",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		params         []cartov1alpha1.EffectiveParam
+		expectedOutput string
+	}{{
+		name:   "no params",
+		params: []cartov1alpha1.EffectiveParam{},
+		expectedOutput: `
+   RESOURCE   PARAM   VALUE   SOURCE
+`,
+	}, {
+		name: "params",
+		params: []cartov1alpha1.EffectiveParam{{
+			Resource: "source-provider",
+			Name:     "gitops_ssh_secret",
+			Value:    &apiextensionsv1.JSON{Raw: []byte(`"git-ssh"`)},
+			Source:   cartov1alpha1.ParamSourceWorkload,
+		}, {
+			Resource: "config-provider",
+			Name:     "annotations",
+			Source:   cartov1alpha1.ParamSourceTemplateDefault,
+		}},
+		expectedOutput: `
+   RESOURCE          PARAM               VALUE       SOURCE
+   source-provider   gitops_ssh_secret   "git-ssh"   workload
+   config-provider   annotations         <empty>     template default
+`,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			if err := printer.WorkloadParamsPrinter(output, workload, test.params); err != nil {
+				t.Errorf("WorkloadParamsPrinter() expected no error, got %v", err)
+			}
+
+			outputString := output.String()
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), outputString); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}